@@ -0,0 +1,7 @@
+# All applications of the server
+data "semaphoreui_apps" "all" {}
+
+# Only the applications that templates can use
+data "semaphoreui_apps" "active" {
+  active = true
+}
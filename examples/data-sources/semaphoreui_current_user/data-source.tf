@@ -0,0 +1,9 @@
+# The user the provider is authenticated as
+data "semaphoreui_current_user" "me" {}
+
+resource "semaphoreui_project_users" "members" {
+  project_id = 1
+  users = {
+    (data.semaphoreui_current_user.me.username) = "owner"
+  }
+}
@@ -0,0 +1,25 @@
+# Check that a GitHub push to the main branch triggers the integration
+data "semaphoreui_integration_webhook_simulation" "push_to_main" {
+  body = jsonencode({
+    ref        = "refs/heads/main"
+    repository = { name = "example" }
+  })
+  headers = {
+    "X-GitHub-Event" = "push"
+  }
+  matchers = [
+    semaphoreui_project_integration_matcher.push_event,
+    semaphoreui_project_integration_matcher.main_branch,
+  ]
+  extract_values = [
+    semaphoreui_project_integration_extract_value.git_ref,
+  ]
+}
+
+output "push_to_main_matched" {
+  value = data.semaphoreui_integration_webhook_simulation.push_to_main.matched
+}
+
+output "push_to_main_git_ref" {
+  value = data.semaphoreui_integration_webhook_simulation.push_to_main.environment["GIT_REF"]
+}
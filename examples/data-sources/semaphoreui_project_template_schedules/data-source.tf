@@ -0,0 +1,9 @@
+data "semaphoreui_project_template_schedules" "schedules" {
+  project_id  = 1
+  template_id = 2
+  timezone    = "Europe/Paris" # The time zone of the SemaphoreUI server
+}
+
+output "template_schedule_names" {
+  value = data.semaphoreui_project_template_schedules.schedules.schedules[*].name
+}
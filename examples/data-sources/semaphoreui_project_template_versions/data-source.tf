@@ -0,0 +1,12 @@
+data "semaphoreui_project_template_versions" "releases" {
+  project_id  = 1
+  template_id = 2 # A build template
+}
+
+output "latest_version" {
+  value = try(data.semaphoreui_project_template_versions.releases.versions[0].version, null)
+}
+
+output "deployed_versions" {
+  value = [for version in data.semaphoreui_project_template_versions.releases.versions : version.version if length(version.deploys) > 0]
+}
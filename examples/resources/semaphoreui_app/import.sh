@@ -0,0 +1,10 @@
+# Import ID is specified by the string "app/{app_id}".
+# - {app_id} is the ID of the application in SemaphoreUI, such as ansible.
+terraform import semaphoreui_app.example app/ansible
+```
+Or using `import {}` block in the configuration file:
+```hcl
+import {
+  to = semaphoreui_app.example
+  id = "app/ansible"
+}
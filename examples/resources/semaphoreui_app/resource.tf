@@ -0,0 +1,21 @@
+# Use a specific Terraform binary
+resource "semaphoreui_app" "terraform" {
+  id   = "terraform"
+  path = "/opt/terraform/1.9/terraform"
+}
+
+# Hide the PowerShell application
+resource "semaphoreui_app" "powershell" {
+  id     = "powershell"
+  active = false
+}
+
+# Custom application
+resource "semaphoreui_app" "deno" {
+  id       = "deno"
+  title    = "Deno"
+  icon     = "mdi-language-typescript"
+  color    = "#70FFAF"
+  path     = "/usr/local/bin/deno"
+  priority = 20
+}
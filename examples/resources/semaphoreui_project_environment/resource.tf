@@ -19,15 +19,16 @@ resource "semaphoreui_project_environment" "environment" {
   }
 
   # secrets
-  secrets = [{
+  secrets = {
     # extraVar Secret
-    name  = "key3"
-    type  = "var"
-    value = "value3"
-    }, {
+    key3 = {
+      type  = "var"
+      value = "value3"
+    }
     # environment Secret
-    name  = "KEY4"
-    type  = "env"
-    value = "value4"
-  }]
+    KEY4 = {
+      type  = "env"
+      value = "value4"
+    }
+  }
 }
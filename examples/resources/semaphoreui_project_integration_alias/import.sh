@@ -0,0 +1,11 @@
+# Import ID is specified by the string "project/{project_id}/alias/{alias_id}".
+# - {project_id} is the ID of the project in SemaphoreUI.
+# - {alias_id} is the ID of the integration alias in SemaphoreUI.
+terraform import semaphoreui_project_integration_alias.example project/1/alias/2
+```
+Or using `import {}` block in the configuration file:
+```hcl
+import {
+  to = semaphoreui_project_integration_alias.example
+  id = "project/1/alias/2"
+}
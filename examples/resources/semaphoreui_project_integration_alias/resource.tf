@@ -0,0 +1,9 @@
+resource "semaphoreui_project_integration_alias" "github" {
+  project_id = 1
+}
+
+# Webhooks sent to this URL trigger the searchable integrations of the
+# project whose matchers match the request.
+output "github_webhook_url" {
+  value = semaphoreui_project_integration_alias.github.webhook_url
+}
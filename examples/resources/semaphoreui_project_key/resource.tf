@@ -20,6 +20,22 @@ resource "semaphoreui_project_key" "ssh" {
   }
 }
 
+resource "semaphoreui_project_key" "ssh_generated" {
+  project_id = semaphoreui_project.project.id
+  name       = "Example Generated SSH"
+  ssh = {
+    login = "git"
+    generate = {
+      algorithm = "ed25519"
+    }
+  }
+}
+
+# Register the public key as a deploy key in the git hosting service
+output "deploy_key" {
+  value = semaphoreui_project_key.ssh_generated.ssh.public_key_openssh
+}
+
 resource "semaphoreui_project_key" "none" {
   project_id = semaphoreui_project.project.id
   name       = "Example None"
@@ -13,4 +13,24 @@ resource "semaphoreui_project_schedule" "schedule" {
   name        = "Example Schedule"
   cron_format = "0 0 * * *"
   enabled     = true
+  timezone    = "Europe/Paris" # The time zone of the SemaphoreUI server
+}
+
+output "schedule_next_runs" {
+  value = semaphoreui_project_schedule.schedule.next_runs
+}
+
+resource "semaphoreui_project_schedule" "release" {
+  project_id  = semaphoreui_project.project.id
+  template_id = data.semaphoreui_project_template.template.id
+  name        = "Example Release"
+  run_at      = "2027-01-31T22:00:00+01:00" # Runs once, SemaphoreUI disables the schedule afterward
+  enabled     = true
+  task_params = {
+    git_branch = "release"
+    arguments  = ["--diff"]
+    survey_values = {
+      version = "1.2.3"
+    }
+  }
 }
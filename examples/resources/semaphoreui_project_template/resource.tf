@@ -29,11 +29,24 @@ resource "semaphoreui_project_inventory" "inventory" {
 resource "semaphoreui_project_environment" "environment" {
   project_id = semaphoreui_project.project.id
   name       = "Environment"
-  secrets = [{
-    name  = "SECRET_ONE"
-    type  = "var"
-    value = "VALUE_ONE"
-  }]
+  secrets = {
+    SECRET_ONE = {
+      type  = "var"
+      value = "VALUE_ONE"
+    }
+    VAULT_PASSWORD = {
+      type  = "env"
+      value = "VAULT_PASSWORD_VALUE"
+    }
+  }
+}
+
+resource "semaphoreui_project_key" "vault" {
+  project_id = semaphoreui_project.project.id
+  name       = "Vault"
+  login_password = {
+    password = "VAULT_PASSWORD_VALUE"
+  }
 }
 
 # Task Template
@@ -49,34 +62,64 @@ resource "semaphoreui_project_template" "task" {
     "--help",
     "--vvv",
   ]
-  allow_override_args_in_task = true
+  allow_override_args_in_task   = true
+  allow_override_branch_in_task = true
 
-  survey_vars = [{
-    name     = "age"
-    title    = "What is your age?"
-    required = true
-    type     = "integer"
-    }, {
-    name  = "question"
-    title = "Pick one."
-    type  = "enum"
-    enum_values = {
-      "First Value"  = "1"
-      "Second Value" = "2"
+  ansible = {
+    limit = ["webservers"]
+    tags  = ["deploy"]
+    diff  = true
+
+    allow_override_limit = true
+  }
+
+  survey_vars = {
+    age = {
+      title    = "What is your age?"
+      required = true
+      type     = "integer"
+      position = 2
     }
-  }]
+    question = {
+      title         = "Pick one."
+      type          = "enum"
+      default_value = "2"
+      position      = 1
+      enum_values = [{
+        name  = "First Value"
+        value = "1"
+        }, {
+        name  = "Second Value"
+        value = "2"
+      }]
+    }
+  }
 
-  vaults = [{
-    name = "" # default vault
-    password = {
-      vault_key_id = semaphoreui_project_key.none.id
+  vaults = {
+    # default vault
+    "" = {
+      password = {
+        vault_key_id = semaphoreui_project_key.vault.id
+      }
     }
-    }, {
-    name = "database"
-    script = {
-      script = "path/to/script-client.py"
+    # password of an environment secret, stored in a key managed with the template
+    secrets = {
+      password = {
+        value = semaphoreui_project_environment.environment.secrets["VAULT_PASSWORD"].value
+      }
     }
-  }]
+    # password read from a local file when the template is applied
+    production = {
+      password = {
+        file = "${path.module}/vault-password.txt"
+      }
+    }
+    database = {
+      client_script = {
+        script = "path/to/script-client.py"
+      }
+    }
+  }
 }
 
 # Build Template
@@ -104,4 +147,13 @@ resource "semaphoreui_project_template" "deploy" {
     build_template_id = semaphoreui_project_template.build.id
     autorun           = false
   }
+
+  # Schedules managed with the template, other schedules of the template are removed
+  schedules = [{
+    name        = "Nightly"
+    cron_format = "0 2 * * *"
+    }, {
+    name   = "Release"
+    run_at = "2027-01-31T22:00:00+01:00"
+  }]
 }
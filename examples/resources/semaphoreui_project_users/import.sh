@@ -0,0 +1,11 @@
+# Import ID is specified by the string "project/{project_id}".
+# - {project_id} is the ID of the project in SemaphoreUI.
+# Imported users are keyed by username.
+terraform import semaphoreui_project_users.example project/1
+```
+Or using `import {}` block in the configuration file:
+```hcl
+import {
+  to = semaphoreui_project_users.example
+  id = "project/1"
+}
@@ -0,0 +1,25 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+resource "semaphoreui_user" "manager" {
+  name     = "Example Manager"
+  username = "manager"
+  email    = "manager@example.com"
+}
+
+resource "semaphoreui_user" "runner" {
+  name     = "Example Runner"
+  username = "runner"
+  email    = "runner@example.com"
+}
+
+resource "semaphoreui_project_users" "members" {
+  project_id = semaphoreui_project.project.id
+  users = {
+    # The user the provider is authenticated as must remain a member of the project.
+    "admin"                              = "owner"
+    (semaphoreui_user.manager.username)  = "manager"
+    tostring(semaphoreui_user.runner.id) = "task_runner"
+  }
+}
@@ -0,0 +1,22 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+resource "semaphoreui_project_views" "views" {
+  project_id = semaphoreui_project.project.id
+  views = [
+    "Build",
+    "Deploy",
+    "Maintenance",
+  ]
+}
+
+resource "semaphoreui_project_template" "backup" {
+  project_id     = semaphoreui_project.project.id
+  environment_id = 1
+  inventory_id   = 2
+  repository_id  = 3
+  name           = "Backup"
+  playbook       = "backup.yml"
+  view_id        = semaphoreui_project_views.views.view_ids["Maintenance"]
+}
@@ -8,3 +8,19 @@ resource "semaphoreui_user" "example" {
   alert    = false
   external = false
 }
+
+# Service account with a generated password, rotated every 30 days
+resource "semaphoreui_user" "service_account" {
+  username = "ci"
+  name     = "CI Service Account"
+  email    = "ci@example.com"
+
+  password_policy = {
+    length  = 40
+    special = false
+  }
+  rotate_after = "720h"
+
+  # Change to force a rotation
+  password_version = "1"
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &appResource{}
+	_ resource.ResourceWithConfigure   = &appResource{}
+	_ resource.ResourceWithImportState = &appResource{}
+)
+
+func NewAppResource() resource.Resource {
+	return &appResource{}
+}
+
+type appResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *appResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *appResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app"
+}
+
+func (r *appResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppSchema().GetResource(ctx)
+}
+
+// convertAppModelToAppRequest returns the application to send to the API. The attributes that are not configured keep
+// the value of the current application, when the server has one.
+func convertAppModelToAppRequest(app AppModel, current *models.App) *models.App {
+	request := &models.App{}
+	if current != nil {
+		*request = *current
+	}
+	request.ID = app.ID.ValueString()
+	if !app.Title.IsUnknown() {
+		request.Title = app.Title.ValueString()
+	}
+	if !app.Active.IsUnknown() {
+		request.Active = app.Active.ValueBool()
+	}
+	if !app.Priority.IsUnknown() {
+		request.Priority = app.Priority.ValueInt64()
+	}
+	if !app.Icon.IsUnknown() {
+		request.Icon = app.Icon.ValueString()
+	}
+	if !app.Color.IsUnknown() {
+		request.Color = app.Color.ValueString()
+	}
+	if !app.DarkColor.IsUnknown() {
+		request.DarkColor = app.DarkColor.ValueString()
+	}
+	if !app.Path.IsUnknown() {
+		request.Path = app.Path.ValueString()
+	}
+	return request
+}
+
+// setApp configures the application of the plan, and returns the configured application.
+func (r *appResource) setApp(plan AppModel) (*models.App, error) {
+	apps, err := getServerApps(r.client)
+	if err != nil {
+		return nil, err
+	}
+	var current *models.App
+	for _, app := range apps {
+		if app.ID == plan.ID.ValueString() {
+			current = app
+		}
+	}
+
+	payload := convertAppModelToAppRequest(plan, current)
+	_, err = r.client.Operations.PutAppsAppID(&operations.PutAppsAppIDParams{AppID: payload.ID, App: payload}, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The server keeps the active state of an application apart from its configuration
+	_, err = r.client.Operations.PostAppsAppIDActive(&operations.PostAppsAppIDActiveParams{AppID: payload.ID, App: &models.AppActive{Active: payload.Active}}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the application, as PutAppsAppID does not return it
+	response, err := r.client.Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: payload.ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// restoreDefaultApp configures a built-in application with its default configuration, and activates it.
+func (r *appResource) restoreDefaultApp(id string) error {
+	payload := defaultApps[id]
+	payload.ID = id
+	payload.Active = true
+	_, err := r.client.Operations.PutAppsAppID(&operations.PutAppsAppIDParams{AppID: id, App: &payload}, nil)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Operations.PostAppsAppIDActive(&operations.PostAppsAppIDActiveParams{AppID: id, App: &models.AppActive{Active: true}}, nil)
+	return err
+}
+
+func (r *appResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.setApp(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI App",
+			"Could not configure app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan = convertResponsePayloadToAppModel(app)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *appResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AppModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: state.ID.ValueString()}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI App",
+			"Could not read app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = convertResponsePayloadToAppModel(response.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *appResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AppModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.setApp(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI App",
+			"Could not update app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan = convertResponsePayloadToAppModel(app)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *appResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AppModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing a built-in application would remove its server configuration, so it is restored instead
+	if _, ok := defaultApps[state.ID.ValueString()]; ok {
+		err := r.restoreDefaultApp(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing SemaphoreUI App",
+				fmt.Sprintf("Could not restore the default configuration of app, unexpected error: %s", err.Error()),
+			)
+		}
+		return
+	}
+
+	_, err := r.client.Operations.DeleteAppsAppID(&operations.DeleteAppsAppIDParams{AppID: state.ID.ValueString()}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing SemaphoreUI App",
+			fmt.Sprintf("Could not remove app, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *appResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := strings.CutPrefix(req.ID, "app/")
+	if !ok || !appIDPattern.MatchString(id) {
+		resp.Diagnostics.AddError(
+			"Invalid App Import ID",
+			fmt.Sprintf("Could not parse import ID %q: expected app/{app_id}, such as app/ansible.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(id))...)
+}
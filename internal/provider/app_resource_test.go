@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccAppExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.Attributes["id"] == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: rs.Primary.Attributes["id"]}, nil)
+		return err
+	}
+}
+
+func testAccAppConfig(appID string, extras string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_app" "test" {
+  id    = "%[1]s"
+  title = "Test %[1]s"
+  path  = "/usr/local/bin/%[1]s"
+  %[2]s
+}`, appID, extras)
+}
+
+func TestAcc_AppResource_basic(t *testing.T) {
+	appID := "test" + acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAppConfig(appID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAppExists("semaphoreui_app.test"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "id", appID),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "title", "Test "+appID),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "path", "/usr/local/bin/"+appID),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "active", "true"),
+					resource.TestCheckResourceAttrSet("semaphoreui_app.test", "priority"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_app.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "app/" + appID,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAppConfig(appID, `
+  active   = false
+  icon     = "mdi-console"
+  color    = "#123456"
+  priority = 5
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAppExists("semaphoreui_app.test"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "active", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "icon", "mdi-console"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "color", "#123456"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "priority", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAppDefaultRestored(appID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		response, err := testClient().Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: appID}, nil)
+		if err != nil {
+			return err
+		}
+		app := response.Payload
+		if app.Title != defaultApps[appID].Title || app.Path != "" || !app.Active {
+			return fmt.Errorf("expected the default configuration of app %s, got %v", appID, app)
+		}
+		return nil
+	}
+}
+
+func TestAcc_AppResource_builtin(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Removing a built-in app restores its default configuration
+		CheckDestroy: testAccAppDefaultRestored("powershell"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig("powershell", "active = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAppExists("semaphoreui_app.test"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "title", "Test powershell"),
+					resource.TestCheckResourceAttr("semaphoreui_app.test", "active", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// appIDPattern is the format of the application IDs.
+var appIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// defaultApps are the built-in applications of the SemaphoreUI server, with their default configuration.
+var defaultApps = map[string]models.App{
+	"ansible":    {Title: "Ansible", Icon: "mdi-ansible", Color: "black", DarkColor: "white", Priority: 500},
+	"terraform":  {Title: "Terraform", Icon: "mdi-terraform", Color: "#7B42BC", DarkColor: "#7B42BC", Priority: 90},
+	"tofu":       {Title: "OpenTofu", Icon: "mdi-language-terraform", Color: "#FFDA18", DarkColor: "#FFDA18", Priority: 80},
+	"terragrunt": {Title: "Terragrunt", Icon: "mdi-layers-outline", Color: "#2A8FD4", DarkColor: "#2A8FD4", Priority: 70},
+	"pulumi":     {Title: "Pulumi", Icon: "mdi-cloud-outline", Color: "#8A3391", DarkColor: "#F7BF2A", Priority: 60},
+	"bash":       {Title: "Bash", Icon: "mdi-bash", Color: "black", DarkColor: "white", Priority: 50},
+	"powershell": {Title: "PowerShell", Icon: "mdi-powershell", Color: "#0077D7", DarkColor: "#0077D7", Priority: 40},
+	"python":     {Title: "Python", Icon: "mdi-language-python", Color: "#3776AB", DarkColor: "#FFD343", Priority: 30},
+}
+
+type AppModel struct {
+	ID        types.String `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	Active    types.Bool   `tfsdk:"active"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Icon      types.String `tfsdk:"icon"`
+	Color     types.String `tfsdk:"color"`
+	DarkColor types.String `tfsdk:"dark_color"`
+	Path      types.String `tfsdk:"path"`
+}
+
+func AppSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The application",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to manage an application of the SemaphoreUI server, such as the default `ansible`, `terraform` or `bash` applications, or a custom application. Requires an admin user. Removing the resource restores the default configuration of a built-in application and activates it, and removes a custom application.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read an application of the SemaphoreUI server.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The application ID, used as the `app` of templates, for example `ansible` or `terraform`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "An ID that is not an application of the server adds a custom application.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(appIDPattern, "must start with a lowercase letter, followed by lowercase letters, digits, `_` or `-`"),
+					},
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"title": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The display name of the application.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"active": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Whether the application is enabled on the server. Templates can only use active applications.",
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+				DataSource: &schemaD.BoolAttribute{
+					Computed: true,
+				},
+			},
+			"priority": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The priority of the application, applications with a higher priority are listed first.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Computed: true,
+				},
+			},
+			"icon": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The icon of the application in the web interface, a [Material Design Icons](https://pictogrammers.com/library/mdi/) name such as `mdi-bash`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"color": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The color of the application icon in the light theme of the web interface.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"dark_color": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The color of the application icon in the dark theme of the web interface.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"path": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The path of the interpreter or binary that runs the application on the server. Empty when the application uses its default binary.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &appsDataSource{}
+	_ datasource.DataSourceWithConfigure = &appsDataSource{}
+)
+
+func NewAppsDataSource() datasource.DataSource {
+	return &appsDataSource{}
+}
+
+type appsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *appsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.Client
+}
+
+// Metadata returns the data source type name.
+func (d *appsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+type appsDataSourceModel struct {
+	Active types.Bool `tfsdk:"active"`
+	Apps   []AppModel `tfsdk:"apps"`
+}
+
+// Schema defines the schema for the data source.
+func (d *appsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the applications of the SemaphoreUI server, such as `ansible`, `terraform` or `bash`, that templates run.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only the applications with this active state are listed.",
+				Optional:            true,
+			},
+			"apps": schema.ListNestedAttribute{
+				MarkdownDescription: "List of applications, by decreasing priority.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: AppSchema().GetDataSource(ctx).Attributes,
+				},
+			},
+		},
+	}
+}
+
+// getServerApps returns the applications of the SemaphoreUI server.
+func getServerApps(client *apiclient.SemaphoreUI) ([]*models.App, error) {
+	response, err := client.Operations.GetApps(&operations.GetAppsParams{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read apps: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+func convertResponsePayloadToAppModel(app *models.App) AppModel {
+	return AppModel{
+		ID:        types.StringValue(app.ID),
+		Title:     types.StringValue(app.Title),
+		Active:    types.BoolValue(app.Active),
+		Priority:  types.Int64Value(app.Priority),
+		Icon:      types.StringValue(app.Icon),
+		Color:     types.StringValue(app.Color),
+		DarkColor: types.StringValue(app.DarkColor),
+		Path:      types.StringValue(app.Path),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *appsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config appsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := getServerApps(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Apps",
+			err.Error(),
+		)
+		return
+	}
+
+	state := appsDataSourceModel{
+		Active: config.Active,
+		Apps:   []AppModel{},
+	}
+	for _, app := range apps {
+		if !config.Active.IsNull() && app.Active != config.Active.ValueBool() {
+			continue
+		}
+		state.Apps = append(state.Apps, convertResponsePayloadToAppModel(app))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
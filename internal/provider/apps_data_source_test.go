@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AppsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "semaphoreui_apps" "all" {}
+
+data "semaphoreui_apps" "active" {
+  active = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.semaphoreui_apps.all", "apps.#"),
+					resource.TestCheckNoResourceAttr("data.semaphoreui_apps.all", "active"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.semaphoreui_apps.all", "apps.*", map[string]string{
+						"id":    "ansible",
+						"title": "Ansible",
+					}),
+					resource.TestCheckResourceAttr("data.semaphoreui_apps.active", "active", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.semaphoreui_apps.active", "apps.*", map[string]string{
+						"id":     "ansible",
+						"active": "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccCurrentUserDataSourceConfig() string {
+	return `
+data "semaphoreui_current_user" "test" {}`
+}
+
+func TestAcc_CurrentUserDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCurrentUserDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_current_user.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_current_user.test", "username", "admin"),
+					resource.TestCheckResourceAttr("data.semaphoreui_current_user.test", "email", "admin@localhost"),
+					resource.TestCheckResourceAttr("data.semaphoreui_current_user.test", "admin", "true"),
+				),
+			},
+		},
+	})
+}
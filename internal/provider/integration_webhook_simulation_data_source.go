@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &integrationWebhookSimulationDataSource{}
+)
+
+func NewIntegrationWebhookSimulationDataSource() datasource.DataSource {
+	return &integrationWebhookSimulationDataSource{}
+}
+
+// integrationWebhookSimulationDataSource does not use the API client, the simulation runs in the provider.
+type integrationWebhookSimulationDataSource struct{}
+
+func (d *integrationWebhookSimulationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_webhook_simulation"
+}
+
+func (d *integrationWebhookSimulationDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = IntegrationWebhookSimulationSchema().GetDataSource(ctx)
+}
+
+// integrationRequest is a webhook request received by an integration.
+type integrationRequest struct {
+	body   string
+	header http.Header
+}
+
+// value returns the value of a header, or of the body. json and xml bodies return the value at the key path, other
+// bodies are returned as is. Values that are not found are empty.
+func (r integrationRequest) value(source string, bodyDataType string, key string) (string, error) {
+	if source == "header" {
+		return r.header.Get(key), nil
+	}
+	switch bodyDataType {
+	case "json":
+		return jsonPathValue(r.body, key)
+	case "xml":
+		return xmlPathValue(r.body, key)
+	}
+	return r.body, nil
+}
+
+// match returns whether the request matches the matcher.
+func (r integrationRequest) match(matcher ProjectIntegrationMatcherModel) (bool, error) {
+	value, err := r.value(matcher.MatchType.ValueString(), matcher.BodyDataType.ValueString(), matcher.Key.ValueString())
+	if err != nil {
+		return false, err
+	}
+	expected := matcher.Value.ValueString()
+	switch matcher.Method.ValueString() {
+	case "equals":
+		return value == expected, nil
+	case "unequals":
+		return value != expected, nil
+	case "contains":
+		return strings.Contains(value, expected), nil
+	}
+	return false, nil
+}
+
+// keyPath splits a json or xml key into its path segments. Array indexes are either segments, or between brackets.
+func keyPath(key string) []string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "$"), ".")
+	key = strings.NewReplacer("[", ".", "]", "").Replace(key)
+	var path []string
+	for _, segment := range strings.Split(key, ".") {
+		if segment != "" {
+			path = append(path, segment)
+		}
+	}
+	return path
+}
+
+// jsonPathValue returns the value at the key path of a JSON document. Strings and numbers are returned as is, and
+// objects and arrays as JSON.
+func jsonPathValue(document string, key string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", fmt.Errorf("invalid JSON body: %s", err.Error())
+	}
+
+	for _, segment := range keyPath(key) {
+		switch current := value.(type) {
+		case map[string]any:
+			value = current[segment]
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(current) {
+				return "", nil
+			}
+			value = current[index]
+		default:
+			return "", nil
+		}
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	}
+	encoded, err := json.Marshal(value)
+	return string(encoded), err
+}
+
+// xmlPathValue returns the text of the element at the key path of an XML document. The path starts with the root
+// element, and indexes select between elements with the same name.
+func xmlPathValue(document string, key string) (string, error) {
+	path := keyPath(key)
+	// matched is the number of path segments matched by an element and its parents, or -1 when the element is
+	// outside of the path. counts is the number of child elements seen by name, to match the indexes.
+	type element struct {
+		matched int
+		counts  map[string]int
+	}
+	stack := []*element{{matched: 0, counts: map[string]int{}}}
+	var text strings.Builder
+	found := false
+
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid XML body: %s", err.Error())
+		}
+
+		top := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			index := top.counts[token.Name.Local]
+			top.counts[token.Name.Local]++
+			current := &element{matched: -1, counts: map[string]int{}}
+			if top.matched >= 0 && top.matched < len(path) && path[top.matched] == token.Name.Local {
+				current.matched = top.matched + 1
+				// An index segment after the element name selects one of the elements with the same name
+				if current.matched < len(path) {
+					if wanted, err := strconv.Atoi(path[current.matched]); err == nil {
+						current.matched = -1
+						if wanted == index {
+							current.matched = top.matched + 2
+						}
+					}
+				}
+			}
+			stack = append(stack, current)
+		case xml.CharData:
+			if !found && top.matched == len(path) {
+				text.Write(token)
+			}
+		case xml.EndElement:
+			if top.matched == len(path) {
+				found = true
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return strings.TrimSpace(text.String()), nil
+}
+
+func (d *integrationWebhookSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IntegrationWebhookSimulationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := integrationRequest{
+		body:   config.Body.ValueString(),
+		header: http.Header{},
+	}
+	for name, value := range config.Headers {
+		request.header.Set(name, value)
+	}
+
+	config.Matched = types.BoolValue(true)
+	config.MatcherResults = make([]types.Bool, 0, len(config.Matchers))
+	for _, matcher := range config.Matchers {
+		matched, err := request.match(matcher.ProjectIntegrationMatcherModel)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Integration Webhook Request",
+				fmt.Sprintf("Could not evaluate matcher %q: %s", matcher.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+		config.MatcherResults = append(config.MatcherResults, types.BoolValue(matched))
+		config.Matched = types.BoolValue(config.Matched.ValueBool() && matched)
+	}
+
+	config.Environment = map[string]string{}
+	config.TaskParams = map[string]string{}
+	for _, extractValue := range config.ExtractValues {
+		value, err := request.value(extractValue.ValueSource.ValueString(), extractValue.BodyDataType.ValueString(), extractValue.Key.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Integration Webhook Request",
+				fmt.Sprintf("Could not extract value %q: %s", extractValue.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+		if extractValue.VariableType.ValueString() == "task" {
+			config.TaskParams[extractValue.Variable.ValueString()] = value
+		} else {
+			config.Environment[extractValue.Variable.ValueString()] = value
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
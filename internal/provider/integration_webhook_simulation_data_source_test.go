@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+func testAccIntegrationWebhookSimulationConfig(ref string) string {
+	return fmt.Sprintf(`
+data "semaphoreui_integration_webhook_simulation" "test" {
+  body = jsonencode({
+    ref = "%[1]s"
+    repository = {
+      name = "example"
+    }
+    commits = [{ id = "abc123" }]
+  })
+  headers = {
+    "x-github-event" = "push"
+  }
+  matchers = [
+    {
+      match_type = "header"
+      method     = "equals"
+      key        = "X-GitHub-Event"
+      value      = "push"
+    },
+    {
+      match_type     = "body"
+      method         = "equals"
+      body_data_type = "json"
+      key            = "ref"
+      value          = "refs/heads/main"
+    },
+  ]
+  extract_values = [
+    {
+      value_source   = "body"
+      body_data_type = "json"
+      key            = "commits.0.id"
+      variable       = "COMMIT"
+    },
+    {
+      value_source  = "header"
+      key           = "X-GitHub-Event"
+      variable      = "event"
+      variable_type = "task"
+    },
+  ]
+}
+`, ref)
+}
+
+func testAccIntegrationWebhookSimulationResourcesConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "semaphoreui_project_integration_matcher" "test" {
+  project_id     = semaphoreui_project.test.id
+  integration_id = semaphoreui_project_integration.test.id
+  name           = "Main Branch"
+  match_type     = "body"
+  method         = "contains"
+  body_data_type = "xml"
+  key            = "push.ref"
+  value          = "main"
+}
+
+resource "semaphoreui_project_integration_extract_value" "test" {
+  project_name   = semaphoreui_project.test.name
+  integration_id = semaphoreui_project_integration.test.id
+  name           = "Repository"
+  value_source   = "body"
+  body_data_type = "xml"
+  key            = "push.repository.name"
+  variable       = "REPOSITORY"
+  variable_type  = "environment"
+}
+
+data "semaphoreui_integration_webhook_simulation" "test" {
+  body           = "<push><ref>refs/heads/main</ref><repository><name>example</name></repository></push>"
+  matchers       = [semaphoreui_project_integration_matcher.test]
+  extract_values = [semaphoreui_project_integration_extract_value.test]
+}
+`, testAccProjectIntegrationConfig(nameSuffix, "Test Integration "+nameSuffix))
+}
+
+func TestAcc_IntegrationWebhookSimulationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationWebhookSimulationConfig("refs/heads/main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matched", "true"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matcher_results.#", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "environment.COMMIT", "abc123"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "task_params.event", "push"),
+				),
+			},
+			{
+				Config: testAccIntegrationWebhookSimulationConfig("refs/heads/feature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matched", "false"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matcher_results.0", "true"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matcher_results.1", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_IntegrationWebhookSimulationDataSource_resources(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationWebhookSimulationResourcesConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matched", "true"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "environment.REPOSITORY", "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_IntegrationWebhookSimulationDataSource_noMatchers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "semaphoreui_integration_webhook_simulation" "test" {
+  body = "anything"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matched", "true"),
+					resource.TestCheckResourceAttr("data.semaphoreui_integration_webhook_simulation.test", "matcher_results.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_IntegrationWebhookSimulationDataSource_invalidBody(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "semaphoreui_integration_webhook_simulation" "test" {
+  body = "not json"
+  matchers = [{
+    match_type     = "body"
+    method         = "equals"
+    body_data_type = "json"
+    key            = "ref"
+    value          = "refs/heads/main"
+  }]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Integration Webhook Request"),
+			},
+		},
+	})
+}
+
+func TestIntegrationWebhookSimulationIgnoredAttributes(t *testing.T) {
+	resourceSchemas := map[string]superschema.Schema{
+		"semaphoreui_project_integration_matcher":       ProjectIntegrationMatcherSchema(),
+		"semaphoreui_project_integration_extract_value": ProjectIntegrationExtractValueSchema(),
+	}
+	for resourceName, resourceSchema := range resourceSchemas {
+		for name, attribute := range resourceSchema.Attributes {
+			if _, ok := integrationWebhookSimulationIgnoredAttribute(attribute); !ok {
+				t.Errorf("unsupported type %T of the attribute %s of %s, ignored by the integration webhook simulation", attribute, name, resourceName)
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type IntegrationWebhookSimulationModel struct {
+	Body           types.String                                  `tfsdk:"body"`
+	Headers        map[string]string                             `tfsdk:"headers"`
+	Matchers       []ProjectIntegrationMatcherResourceModel      `tfsdk:"matchers"`
+	ExtractValues  []ProjectIntegrationExtractValueResourceModel `tfsdk:"extract_values"`
+	Matched        types.Bool                                    `tfsdk:"matched"`
+	MatcherResults []types.Bool                                  `tfsdk:"matcher_results"`
+	Environment    map[string]string                             `tfsdk:"environment"`
+	TaskParams     map[string]string                             `tfsdk:"task_params"`
+}
+
+// addIntegrationWebhookSimulationIgnoredAttributes adds the attributes of the resource schema that the simulation does
+// not use to the attributes, so the resources can be passed as is. The attributes are built from the resource schema,
+// so they follow the attributes added to the resource, which must also be in the resource model. Attributes of types
+// without an ignored counterpart are skipped, TestIntegrationWebhookSimulationIgnoredAttributes checks there are none.
+func addIntegrationWebhookSimulationIgnoredAttributes(attributes map[string]superschema.Attribute, resourceSchema superschema.Schema) {
+	for name, attribute := range resourceSchema.Attributes {
+		if _, ok := attributes[name]; ok {
+			continue
+		}
+		if ignored, ok := integrationWebhookSimulationIgnoredAttribute(attribute); ok {
+			attributes[name] = ignored
+		}
+	}
+}
+
+// integrationWebhookSimulationIgnoredAttribute returns the optional data source attribute accepting the values of the
+// resource attribute, or false when the type of the attribute is not supported.
+func integrationWebhookSimulationIgnoredAttribute(attribute superschema.Attribute) (superschema.Attribute, bool) {
+	switch attribute.(type) {
+	case superschema.Int64Attribute:
+		return superschema.Int64Attribute{
+			DataSource: &schemaD.Int64Attribute{
+				MarkdownDescription: "Ignored, accepted to pass resources as is.",
+				Optional:            true,
+			},
+		}, true
+	case superschema.StringAttribute:
+		return superschema.StringAttribute{
+			DataSource: &schemaD.StringAttribute{
+				MarkdownDescription: "Ignored, accepted to pass resources as is.",
+				Optional:            true,
+			},
+		}, true
+	case superschema.BoolAttribute:
+		return superschema.BoolAttribute{
+			DataSource: &schemaD.BoolAttribute{
+				MarkdownDescription: "Ignored, accepted to pass resources as is.",
+				Optional:            true,
+			},
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func IntegrationWebhookSimulationSchema() superschema.Schema {
+	matcherAttributes := map[string]superschema.Attribute{}
+	matcherAttributes["name"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The display name, used in error messages.",
+			Optional:            true,
+		},
+	}
+	matcherAttributes["match_type"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "Where to look for the match. Valid values are `body` or `header`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("body", "header"),
+			},
+		},
+	}
+	matcherAttributes["method"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The comparison method. Valid values are `equals`, `unequals`, or `contains`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("equals", "unequals", "contains"),
+			},
+		},
+	}
+	matcherAttributes["body_data_type"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The data type of the body. Valid values are `json`, `xml`, or `string`. The whole body is compared when not set, or set to `string`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("json", "xml", "string"),
+			},
+		},
+	}
+	matcherAttributes["key"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The header name, or the path of the value in `json` and `xml` bodies, such as `repository.name` or `commits.0.id`. A leading `$.` is ignored.",
+			Optional:            true,
+		},
+	}
+	matcherAttributes["value"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The value to compare against.",
+			Optional:            true,
+		},
+	}
+
+	addIntegrationWebhookSimulationIgnoredAttributes(matcherAttributes, ProjectIntegrationMatcherSchema())
+
+	extractValueAttributes := map[string]superschema.Attribute{}
+	extractValueAttributes["name"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The display name, used in error messages.",
+			Optional:            true,
+		},
+	}
+	extractValueAttributes["value_source"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "Where to extract the value from. Valid values are `body` or `header`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("body", "header"),
+			},
+		},
+	}
+	extractValueAttributes["body_data_type"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The data type of the body. Valid values are `json`, `xml`, or `string`. The whole body is extracted when not set, or set to `string`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("json", "xml", "string"),
+			},
+		},
+	}
+	extractValueAttributes["key"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The header name, or the path of the value in `json` and `xml` bodies.",
+			Optional:            true,
+		},
+	}
+	extractValueAttributes["variable"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The variable name to store the extracted value.",
+			Required:            true,
+		},
+	}
+	extractValueAttributes["variable_type"] = superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: "The type of variable to set. Valid values are `environment` or `task`. Defaults to `environment`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("environment", "task"),
+			},
+		},
+	}
+	addIntegrationWebhookSimulationIgnoredAttributes(extractValueAttributes, ProjectIntegrationExtractValueSchema())
+
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The integration webhook simulation",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source evaluates integration matchers and extract values against a sample webhook request, without sending it to SemaphoreUI. It does not call the SemaphoreUI API, use it to check the routing of webhooks in `terraform test`. The `semaphoreui_project_integration_matcher` and `semaphoreui_project_integration_extract_value` resources can be passed as is in `matchers` and `extract_values`.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"body": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The body of the sample webhook request.",
+					Optional:            true,
+				},
+			},
+			"headers": superschema.MapAttribute{
+				DataSource: &schemaD.MapAttribute{
+					MarkdownDescription: "The headers of the sample webhook request. Header names are case insensitive.",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+			"matchers": superschema.ListNestedAttribute{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The matchers of the integration.",
+					Optional:            true,
+				},
+				Attributes: matcherAttributes,
+			},
+			"extract_values": superschema.ListNestedAttribute{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The extract values of the integration.",
+					Optional:            true,
+				},
+				Attributes: extractValueAttributes,
+			},
+			"matched": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether the request matches the integration: all the matchers match. An integration without matchers matches every request, as SemaphoreUI runs it for every webhook. SemaphoreUI only triggers searchable integrations on project aliases when they match.",
+					Computed:            true,
+				},
+			},
+			"matcher_results": superschema.ListAttribute{
+				DataSource: &schemaD.ListAttribute{
+					MarkdownDescription: "Whether each of the `matchers` matches the request, in the same order.",
+					ElementType:         types.BoolType,
+					Computed:            true,
+				},
+			},
+			"environment": superschema.MapAttribute{
+				DataSource: &schemaD.MapAttribute{
+					MarkdownDescription: "The environment variables extracted from the request, passed to the task.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+			"task_params": superschema.MapAttribute{
+				DataSource: &schemaD.MapAttribute{
+					MarkdownDescription: "The task parameters extracted from the request, values of extract values with the `task` variable type.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
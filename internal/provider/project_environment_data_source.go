@@ -26,15 +26,15 @@ func (d *projectEnvironmentDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.Client
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +65,10 @@ func (d *projectEnvironmentDataSource) Read(ctx context.Context, req datasource.
 		)
 		return
 	}
+	resp.Diagnostics.Append(validateEnvironmentSecretNames(response.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &config)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
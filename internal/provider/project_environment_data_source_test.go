@@ -29,17 +29,18 @@ resource "semaphoreui_project_environment" "test" {
   }
 
   # secrets
-  secrets = [{
+  secrets = {
     # extraVar Secret
-    name  = "key3"
-    type  = "var"
-    value = "value3"
-    }, {
+    key3 = {
+      type  = "var"
+      value = "value3"
+    }
     # environment Secret
-    name  = "KEY4"
-    type  = "env"
-    value = "value4"
-  }]
+    KEY4 = {
+      type  = "env"
+      value = "value4"
+    }
+  }
 }
 
 data "semaphoreui_project_environment" "test" {
@@ -65,13 +66,11 @@ func TestAcc_ProjectEnvironmentDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "environment.%", "2"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "environment.KEY1", "value1"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "environment.KEY2", "value2"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.#", "2"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.0.name", "key3"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.0.value", ""),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.0.type", "var"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.1.name", "KEY4"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.1.value", ""),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.1.type", "env"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.key3.value", ""),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.key3.type", "var"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.KEY4.value", ""),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environment.test", "secrets.KEY4.type", "env"),
 				),
 			},
 		},
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"sort"
+	"maps"
+	"slices"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"terraform-provider-semaphoreui/semaphoreui/models"
@@ -15,9 +17,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &projectEnvironmentResource{}
-	_ resource.ResourceWithConfigure   = &projectEnvironmentResource{}
-	_ resource.ResourceWithImportState = &projectEnvironmentResource{}
+	_ resource.Resource                 = &projectEnvironmentResource{}
+	_ resource.ResourceWithConfigure    = &projectEnvironmentResource{}
+	_ resource.ResourceWithImportState  = &projectEnvironmentResource{}
+	_ resource.ResourceWithModifyPlan   = &projectEnvironmentResource{}
+	_ resource.ResourceWithUpgradeState = &projectEnvironmentResource{}
 )
 
 func NewProjectEnvironmentResource() resource.Resource {
@@ -33,16 +37,16 @@ func (r *projectEnvironmentResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,15 +57,13 @@ func (model ProjectEnvironmentModel) SecretValue(ctx context.Context, name strin
 	if model.Secrets.IsNull() || model.Secrets.IsUnknown() {
 		return types.StringValue("")
 	}
-	var secrets []ProjectEnvironmentSecretModel
+	var secrets map[string]ProjectEnvironmentSecretModel
 	diags := model.Secrets.ElementsAs(ctx, &secrets, false)
 	if diags.HasError() {
 		return types.StringValue("")
 	}
-	for _, secret := range secrets {
-		if secret.Name.Equal(types.StringValue(name)) && secret.Type.Equal(types.StringValue(varType)) {
-			return secret.Value
-		}
+	if secret, ok := secrets[name]; ok && secret.Type.Equal(types.StringValue(varType)) {
+		return secret.Value
 	}
 	return types.StringValue("")
 }
@@ -71,7 +73,7 @@ func (model ProjectEnvironmentModel) Secret(ctx context.Context, id types.Int64)
 		return nil
 	}
 
-	var secrets []ProjectEnvironmentSecretModel
+	var secrets map[string]ProjectEnvironmentSecretModel
 	diags := model.Secrets.ElementsAs(ctx, &secrets, false)
 	if diags.HasError() {
 		return nil
@@ -87,6 +89,43 @@ func (model ProjectEnvironmentModel) Secret(ctx context.Context, id types.Int64)
 
 func (r *projectEnvironmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = ProjectEnvironmentSchema().GetResource(ctx)
+	resp.Schema.Version = 1
+}
+
+func (r *projectEnvironmentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeRawState(upgradeProjectEnvironmentStateV0)},
+	}
+}
+
+// upgradeProjectEnvironmentStateV0 converts the secrets from a list to a map by name. SemaphoreUI allows a `var` and
+// an `env` secret with the same name, which the map can't keep, so they are an error.
+func upgradeProjectEnvironmentStateV0(state map[string]any) error {
+	secrets, err := convertStateListToMap(state["secrets"], "name")
+	if err != nil {
+		return fmt.Errorf("secrets: %w, rename one of the secrets in SemaphoreUI", err)
+	}
+	state["secrets"] = secrets
+	return nil
+}
+
+// validateEnvironmentSecretNames checks that the secrets of the environment have different names, as the secrets are
+// managed by name. SemaphoreUI only requires the name to be unique for the type of the secret.
+func validateEnvironmentSecretNames(environment *models.Environment) diag.Diagnostics {
+	var diags diag.Diagnostics
+	names := map[string]string{}
+	for _, secret := range environment.Secrets {
+		if secretType, ok := names[secret.Name]; ok {
+			diags.AddError(
+				"Duplicate SemaphoreUI Environment Secret Name",
+				fmt.Sprintf("The environment %d has a %q and a %q secret named %q. The secrets are managed by name, rename one of them in SemaphoreUI.",
+					environment.ID, secretType, secret.Type, secret.Name),
+			)
+			continue
+		}
+		names[secret.Name] = secret.Type
+	}
+	return diags
 }
 
 func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env ProjectEnvironmentModel, prev *ProjectEnvironmentModel) *models.EnvironmentRequest {
@@ -113,21 +152,18 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 	}
 
 	var secrets []*models.EnvironmentSecretRequest
-	var envSecrets, prevSecrets []ProjectEnvironmentSecretModel
-	if env.Secrets.IsNull() || env.Secrets.IsUnknown() {
-		envSecrets = []ProjectEnvironmentSecretModel{}
-	} else {
+	var envSecrets, prevSecrets map[string]ProjectEnvironmentSecretModel
+	if !env.Secrets.IsNull() && !env.Secrets.IsUnknown() {
 		env.Secrets.ElementsAs(ctx, &envSecrets, false)
 	}
-	if prev.Secrets.IsUnknown() || prev.Secrets.IsNull() {
-		prevSecrets = []ProjectEnvironmentSecretModel{}
-	} else {
+	if !prev.Secrets.IsUnknown() && !prev.Secrets.IsNull() {
 		prev.Secrets.ElementsAs(ctx, &prevSecrets, false)
 	}
 
-	for _, secret := range envSecrets {
+	for _, name := range slices.Sorted(maps.Keys(envSecrets)) {
+		secret := envSecrets[name]
 		modelSecret := models.EnvironmentSecretRequest{
-			Name: secret.Name.ValueString(),
+			Name: name,
 			Type: secret.Type.ValueString(),
 		}
 		// Create all secrets from env missing an ID
@@ -139,12 +175,9 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 			// Find the previous secret
 			prevSecret := prev.Secret(ctx, secret.ID)
 			if prevSecret != nil {
-				// Update if any field has changed
-				if !secret.Name.Equal(prevSecret.Name) || !secret.Value.Equal(prevSecret.Value) || !secret.Type.Equal(prevSecret.Type) {
+				// Update if any field has changed, a renamed secret has no ID yet
+				if !secret.Value.Equal(prevSecret.Value) || !secret.Type.Equal(prevSecret.Type) {
 					modelSecret.Operation = "update"
-					if !secret.Name.Equal(prevSecret.Name) {
-						modelSecret.Name = secret.Name.ValueString()
-					}
 				}
 			}
 		}
@@ -152,7 +185,8 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 	}
 
 	// Delete all secrets from prev with an ID missing from env
-	for _, prevSecret := range prevSecrets {
+	for _, name := range slices.Sorted(maps.Keys(prevSecrets)) {
+		prevSecret := prevSecrets[name]
 		secret := env.Secret(ctx, prevSecret.ID)
 		if secret == nil {
 			secrets = append(secrets, &models.EnvironmentSecretRequest{
@@ -169,14 +203,6 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 	return &model
 }
 
-var _ sort.Interface = ByEnvironmentID{}
-
-type ByEnvironmentID []*models.EnvironmentSecret
-
-func (a ByEnvironmentID) Len() int           { return len(a) }
-func (a ByEnvironmentID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a ByEnvironmentID) Less(i, j int) bool { return a[i].ID < a[j].ID }
-
 func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, environment *models.Environment, prev *ProjectEnvironmentModel) ProjectEnvironmentModel {
 	model := ProjectEnvironmentModel{
 		ID:        types.Int64Value(environment.ID),
@@ -198,14 +224,11 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 		model.Environment = nil
 	}
 
-	sort.Sort(ByEnvironmentID(environment.Secrets))
-
-	var secrets []ProjectEnvironmentSecretModel
+	secrets := map[string]ProjectEnvironmentSecretModel{}
 	for _, secret := range environment.Secrets {
 		modelSecret := ProjectEnvironmentSecretModel{
 			ID:   types.Int64Value(secret.ID),
 			Type: types.StringValue(secret.Type),
-			Name: types.StringValue(secret.Name),
 		}
 		// Value from previous state since secrets are not returned in the response
 		prevSecret := prev.Secret(ctx, modelSecret.ID)
@@ -214,17 +237,19 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 		} else {
 			modelSecret.Value = prev.SecretValue(ctx, secret.Name, secret.Type)
 		}
-		secrets = append(secrets, modelSecret)
+		secrets[secret.Name] = modelSecret
 	}
-	if len(secrets) == 0 && !prev.Secrets.IsNull() && !prev.Secrets.IsUnknown() {
-		prev.Secrets.ElementsAs(ctx, &secrets, false)
+	if len(secrets) == 0 {
+		secrets = nil
+		if !prev.Secrets.IsNull() && !prev.Secrets.IsUnknown() {
+			prev.Secrets.ElementsAs(ctx, &secrets, false)
+		}
 	}
 
-	envSecrets, _ := types.ListValueFrom(ctx, types.ObjectType{
+	envSecrets, _ := types.MapValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"id":    types.Int64Type,
 			"type":  types.StringType,
-			"name":  types.StringType,
 			"value": types.StringType,
 		},
 	}, secrets)
@@ -236,7 +261,8 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 
 func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectEnvironmentModel
+	var plan ProjectEnvironmentResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -245,7 +271,7 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 	//Create new projectEnvironment
 	response, err := r.client.Project.PostProjectProjectIDEnvironment(&project.PostProjectProjectIDEnvironmentParams{
 		ProjectID:   plan.ProjectID.ValueInt64(),
-		Environment: convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, &ProjectEnvironmentModel{}),
+		Environment: convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan.ProjectEnvironmentModel, &ProjectEnvironmentModel{}),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -266,7 +292,11 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 		)
 		return
 	}
-	plan = convertEnvironmentResponseToProjectEnvironmentModel(ctx, payload.Payload, &plan)
+	resp.Diagnostics.Append(validateEnvironmentSecretNames(payload.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ProjectEnvironmentModel = convertEnvironmentResponseToProjectEnvironmentModel(ctx, payload.Payload, &plan.ProjectEnvironmentModel)
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -278,7 +308,7 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 // Read refreshes the Terraform state with the latest data.
 func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectEnvironmentModel
+	var state ProjectEnvironmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,10 +325,14 @@ func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.Read
 		)
 		return
 	}
-	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &state)
+	resp.Diagnostics.Append(validateEnvironmentSecretNames(response.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ProjectEnvironmentModel = convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &state.ProjectEnvironmentModel)
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -307,7 +341,8 @@ func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.Read
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan, state ProjectEnvironmentModel
+	var plan, state ProjectEnvironmentResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -317,7 +352,7 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 	_, err := r.client.Project.PutProjectProjectIDEnvironmentEnvironmentID(&project.PutProjectProjectIDEnvironmentEnvironmentIDParams{
 		ProjectID:     plan.ProjectID.ValueInt64(),
 		EnvironmentID: plan.ID.ValueInt64(),
-		Environment:   convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, &state),
+		Environment:   convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan.ProjectEnvironmentModel, &state.ProjectEnvironmentModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -338,17 +373,26 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 		)
 		return
 	}
-	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &plan)
+	resp.Diagnostics.Append(validateEnvironmentSecretNames(response.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ProjectEnvironmentModel = convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &plan.ProjectEnvironmentModel)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan resolves the project name of the environment.
+func (r *projectEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+}
+
 func (r *projectEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectEnvironmentModel
+	var state ProjectEnvironmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -389,7 +433,11 @@ func (r *projectEnvironmentResource) ImportState(ctx context.Context, req resour
 		)
 		return
 	}
-	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &ProjectEnvironmentModel{})
+	resp.Diagnostics.Append(validateEnvironmentSecretNames(response.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := ProjectEnvironmentResourceModel{ProjectEnvironmentModel: convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &ProjectEnvironmentModel{})}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
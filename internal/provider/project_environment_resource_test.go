@@ -85,15 +85,15 @@ func testAccProjectEnvironmentConfig(
 
 	if secrets != nil {
 		for _, s := range *secrets {
-			secs += fmt.Sprintf(`{
-	  name = "%s"
+			secs += fmt.Sprintf(`%s = {
 	  value = "%s"
       type = "%s"
-	},`, s.Name, s.Value, s.Type)
+	}
+`, s.Name, s.Value, s.Type)
 		}
-		secs = fmt.Sprintf(`secrets = [
+		secs = fmt.Sprintf(`secrets = {
 	%s
-  ]`, secs)
+  }`, secs)
 	}
 
 	return fmt.Sprintf(`
@@ -155,7 +155,7 @@ func TestAcc_ProjectEnvironmentResource_basic(t *testing.T) {
 
 					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "variables.%", "0"),
 					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "environment.%", "0"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "0"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.%", "0"),
 
 					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "project_id"),
@@ -308,15 +308,13 @@ func TestAcc_ProjectEnvironmentResource_basicSecrets(t *testing.T) {
 					testAccProjectEnvironmentExists("semaphoreui_project_environment.test"),
 					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "2"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.0.id"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.name", "FOO"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value", "BAR"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.type", "var"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.1.id"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.name", "BAZ"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.value", "QUX"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.type", "env"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.%", "2"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.FOO.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.FOO.value", "BAR"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.FOO.type", "var"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.BAZ.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.BAZ.value", "QUX"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.BAZ.type", "env"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "variables"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "environment"),
@@ -332,10 +330,10 @@ func TestAcc_ProjectEnvironmentResource_basicSecrets(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: testAccProjectEnvironmentImportID("semaphoreui_project_environment.test"),
 				// Secret values can't be imported and are set to empty strings
-				ImportStateVerifyIgnore: []string{"secrets.0.value", "secrets.1.value"},
+				ImportStateVerifyIgnore: []string{"secrets.FOO.value", "secrets.BAZ.value"},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value", ""),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.value", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.FOO.value", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.BAZ.value", ""),
 				),
 			},
 			// Update and Read testing
@@ -349,15 +347,13 @@ func TestAcc_ProjectEnvironmentResource_basicSecrets(t *testing.T) {
 					testAccProjectEnvironmentExists("semaphoreui_project_environment.test"),
 					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "2"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.0.id"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.name", "NAME"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value", "BAR"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.type", "var"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.1.id"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.name", "BAZ"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.value", "VALUE"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.1.type", "env"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.%", "2"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.NAME.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.NAME.value", "BAR"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.NAME.type", "var"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.BAZ.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.BAZ.value", "VALUE"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.BAZ.type", "env"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "variables"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "environment"),
@@ -376,11 +372,10 @@ func TestAcc_ProjectEnvironmentResource_basicSecrets(t *testing.T) {
 					testAccProjectEnvironmentExists("semaphoreui_project_environment.test"),
 					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "1"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.0.id"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.name", "NAME"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value", "BAR"),
-					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.type", "env"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.%", "1"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.NAME.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.NAME.value", "BAR"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.NAME.type", "env"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "variables"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "environment"),
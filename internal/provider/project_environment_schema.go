@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,13 +20,17 @@ type (
 		Name        types.String       `tfsdk:"name"`
 		Variables   *map[string]string `tfsdk:"variables"`
 		Environment *map[string]string `tfsdk:"environment"`
-		Secrets     types.List         `tfsdk:"secrets"`
+		Secrets     types.Map          `tfsdk:"secrets"`
+	}
+
+	ProjectEnvironmentResourceModel struct {
+		ProjectEnvironmentModel
+		ProjectName types.String `tfsdk:"project_name"`
 	}
 
 	ProjectEnvironmentSecretModel struct {
 		ID    types.Int64  `tfsdk:"id"`
 		Type  types.String `tfsdk:"type"`
-		Name  types.String `tfsdk:"name"`
 		Value types.String `tfsdk:"value"`
 	}
 )
@@ -56,10 +62,26 @@ func ProjectEnvironmentSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the environment belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the environment belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"name": superschema.StringAttribute{
@@ -97,14 +119,14 @@ func ProjectEnvironmentSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
-			"secrets": superschema.ListNestedAttribute{
-				Common: &schemaR.ListNestedAttribute{
-					MarkdownDescription: "Secret variables of either `\"var\"` or `\"env\"` type. The `value` is encrypted and will be empty if imported.",
+			"secrets": superschema.MapNestedAttribute{
+				Common: &schemaR.MapNestedAttribute{
+					MarkdownDescription: "Secret variables of either `\"var\"` or `\"env\"` type, by variable name. The `value` is encrypted and will be empty if imported. SemaphoreUI allows a `\"var\"` and an `\"env\"` secret with the same name, but the provider can't manage them and reports an error for such an environment.",
 				},
-				Resource: &schemaR.ListNestedAttribute{
+				Resource: &schemaR.MapNestedAttribute{
 					Optional: true,
 				},
-				DataSource: &schemaD.ListNestedAttribute{
+				DataSource: &schemaD.MapNestedAttribute{
 					Computed: true,
 				},
 				Attributes: map[string]superschema.Attribute{
@@ -131,17 +153,6 @@ func ProjectEnvironmentSchema() superschema.Schema {
 							Computed: true,
 						},
 					},
-					"name": superschema.StringAttribute{
-						Common: &schemaR.StringAttribute{
-							MarkdownDescription: "The variable name.",
-						},
-						Resource: &schemaR.StringAttribute{
-							Required: true,
-						},
-						DataSource: &schemaD.StringAttribute{
-							Computed: true,
-						},
-					},
 					"value": superschema.StringAttribute{
 						Common: &schemaR.StringAttribute{
 							MarkdownDescription: "The variable value.",
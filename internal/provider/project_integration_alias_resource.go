@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectIntegrationAliasResource{}
+	_ resource.ResourceWithConfigure   = &projectIntegrationAliasResource{}
+	_ resource.ResourceWithImportState = &projectIntegrationAliasResource{}
+	_ resource.ResourceWithModifyPlan  = &projectIntegrationAliasResource{}
+)
+
+func NewProjectIntegrationAliasResource() resource.Resource {
+	return &projectIntegrationAliasResource{}
+}
+
+type projectIntegrationAliasResource struct {
+	client  *apiclient.SemaphoreUI
+	baseURL string
+}
+
+func (r *projectIntegrationAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.Client
+	r.baseURL = data.BaseURL
+}
+
+func (r *projectIntegrationAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_integration_alias"
+}
+
+func (r *projectIntegrationAliasResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectIntegrationAliasSchema().GetResource(ctx)
+}
+
+// integrationAliasName returns the alias, the last path segment of the alias URL. The API returns the URL of the alias
+// endpoint built from the SemaphoreUI web host, instead of the alias itself.
+func integrationAliasName(alias *models.IntegrationAlias) string {
+	return path.Base(strings.TrimSuffix(alias.URL, "/"))
+}
+
+// integrationWebhookURL returns the URL of the webhook endpoint of the alias, built from the API base URL the provider
+// is configured with rather than the SemaphoreUI web host, which is not always reachable by the webhook senders.
+func integrationWebhookURL(baseURL string, alias string) string {
+	return baseURL + "/integrations/" + alias
+}
+
+// getIntegrationAliases returns the aliases of the integration, or of the project when integrationID is 0.
+func getIntegrationAliases(client *apiclient.SemaphoreUI, projectID int64, integrationID int64) ([]*models.IntegrationAlias, error) {
+	if integrationID == 0 {
+		response, err := client.Integration.GetProjectProjectIDIntegrationsAliases(&integration.GetProjectProjectIDIntegrationsAliasesParams{
+			ProjectID: projectID,
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not read integration aliases: %s", err.Error())
+		}
+		return response.Payload, nil
+	}
+
+	response, err := client.Integration.GetProjectProjectIDIntegrationsIntegrationIDAliases(&integration.GetProjectProjectIDIntegrationsIntegrationIDAliasesParams{
+		ProjectID:     projectID,
+		IntegrationID: integrationID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read integration aliases: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+// createIntegrationAlias creates an alias for the integration, or for the project when integrationID is 0.
+func createIntegrationAlias(client *apiclient.SemaphoreUI, projectID int64, integrationID int64) (*models.IntegrationAlias, error) {
+	if integrationID == 0 {
+		response, err := client.Integration.PostProjectProjectIDIntegrationsAliases(&integration.PostProjectProjectIDIntegrationsAliasesParams{
+			ProjectID: projectID,
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not create integration alias: %s", err.Error())
+		}
+		return response.Payload, nil
+	}
+
+	response, err := client.Integration.PostProjectProjectIDIntegrationsIntegrationIDAliases(&integration.PostProjectProjectIDIntegrationsIntegrationIDAliasesParams{
+		ProjectID:     projectID,
+		IntegrationID: integrationID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create integration alias: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+// deleteIntegrationAlias deletes an alias of the integration, or of the project when integrationID is 0.
+func deleteIntegrationAlias(client *apiclient.SemaphoreUI, projectID int64, integrationID int64, aliasID int64) error {
+	var err error
+	if integrationID == 0 {
+		_, err = client.Integration.DeleteProjectProjectIDIntegrationsAliasesAliasID(&integration.DeleteProjectProjectIDIntegrationsAliasesAliasIDParams{
+			ProjectID: projectID,
+			AliasID:   aliasID,
+		}, nil)
+	} else {
+		_, err = client.Integration.DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID(&integration.DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDParams{
+			ProjectID:     projectID,
+			IntegrationID: integrationID,
+			AliasID:       aliasID,
+		}, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("could not remove integration alias: %s", err.Error())
+	}
+	return nil
+}
+
+func convertIntegrationAliasToProjectIntegrationAliasModel(baseURL string, projectID int64, alias *models.IntegrationAlias) ProjectIntegrationAliasModel {
+	return ProjectIntegrationAliasModel{
+		ID:         types.Int64Value(alias.ID),
+		ProjectID:  types.Int64Value(projectID),
+		Alias:      types.StringValue(integrationAliasName(alias)),
+		WebhookURL: types.StringValue(integrationWebhookURL(baseURL, integrationAliasName(alias))),
+	}
+}
+
+// getProjectIntegrationAliasByID retrieves a project level integration alias by ID from the list of project aliases.
+func getProjectIntegrationAliasByID(client *apiclient.SemaphoreUI, projectID int64, aliasID int64) (*models.IntegrationAlias, error) {
+	aliases, err := getIntegrationAliases(client, projectID, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		if alias.ID == aliasID {
+			return alias, nil
+		}
+	}
+	return nil, fmt.Errorf("project integration alias with ID %d not found", aliasID)
+}
+
+func (r *projectIntegrationAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ProjectIntegrationAliasModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := createIntegrationAlias(r.client, plan.ProjectID.ValueInt64(), 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration Alias",
+			"Could not create project integration alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	model := convertIntegrationAliasToProjectIntegrationAliasModel(r.baseURL, plan.ProjectID.ValueInt64(), alias)
+	model.ProjectName = plan.ProjectName
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectIntegrationAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ProjectIntegrationAliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := getProjectIntegrationAliasByID(r.client, state.ProjectID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration Alias",
+			err.Error(),
+		)
+		return
+	}
+	model := convertIntegrationAliasToProjectIntegrationAliasModel(r.baseURL, state.ProjectID.ValueInt64(), alias)
+	model.ProjectName = state.ProjectName
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only changes the project name, which references the project of the alias and is not sent to SemaphoreUI,
+// the other attributes are computed or require the alias to be replaced. It copies the resolved plan into the state.
+func (r *projectIntegrationAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectIntegrationAliasModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan resolves the project name of the alias.
+func (r *projectIntegrationAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+}
+
+func (r *projectIntegrationAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ProjectIntegrationAliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteIntegrationAlias(r.client, state.ProjectID.ValueInt64(), 0, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing SemaphoreUI Project Integration Alias",
+			"Could not remove project integration alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectIntegrationAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(req.ID, []string{"project", "alias"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Project Integration Alias Import ID",
+			"Could not parse import ID: "+err.Error(),
+		)
+		return
+	}
+
+	alias, err := getProjectIntegrationAliasByID(r.client, fields["project"], fields["alias"])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration Alias",
+			err.Error(),
+		)
+		return
+	}
+	model := convertIntegrationAliasToProjectIntegrationAliasModel(r.baseURL, fields["project"], alias)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectIntegrationAliasExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.Attributes["id"] == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		id, _ := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		projectId, _ := strconv.ParseInt(rs.Primary.Attributes["project_id"], 10, 64)
+
+		alias, err := getProjectIntegrationAliasByID(testClient(), projectId, id)
+		if err != nil {
+			return fmt.Errorf("error reading project integration alias: %s", err.Error())
+		}
+
+		if integrationAliasName(alias) != rs.Primary.Attributes["alias"] {
+			return fmt.Errorf("integration alias mismatch: %s != %s", integrationAliasName(alias), rs.Primary.Attributes["alias"])
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectIntegrationAliasConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_project_integration_alias" "test" {
+  project_id = semaphoreui_project.test.id
+}
+`, nameSuffix)
+}
+
+func testAccProjectIntegrationAliasImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%[1]s/alias/%[2]s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestAcc_ProjectIntegrationAliasResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectIntegrationAliasConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationAliasExists("semaphoreui_project_integration_alias.test"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration_alias.test", "id"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration_alias.test", "alias"),
+					resource.TestMatchResourceAttr("semaphoreui_project_integration_alias.test", "webhook_url", regexp.MustCompile(`/api/integrations/\w+$`)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_project_integration_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectIntegrationAliasImportID("semaphoreui_project_integration_alias.test"),
+			},
+			// Delete testing
+			{
+				Config: `
+resource "semaphoreui_project" "test" {
+  name = "test-` + nameSuffix + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_integration_alias.test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type ProjectIntegrationAliasModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	Alias       types.String `tfsdk:"alias"`
+	WebhookURL  types.String `tfsdk:"webhook_url"`
+}
+
+func ProjectIntegrationAliasSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project integration alias",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to manage a project level integration alias. Webhooks sent to the alias endpoint are routed to the searchable integrations of the project (`searchable = true`) whose matchers match the request. SemaphoreUI generates the alias, it can not be chosen.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The alias ID.",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The project ID that the alias belongs to.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the alias belongs to, instead of `project_id`.",
+					Optional:            true,
+				},
+			},
+			"alias": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The alias generated by SemaphoreUI, the last path segment of the webhook URL.",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+			"webhook_url": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The URL of the webhook endpoint of the alias, built from the provider `api_base_url`. Configure it as the webhook URL in GitHub, Bitbucket, or other systems sending webhooks.",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+		},
+	}
+}
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &projectIntegrationExtractValueResource{}
 	_ resource.ResourceWithConfigure   = &projectIntegrationExtractValueResource{}
 	_ resource.ResourceWithImportState = &projectIntegrationExtractValueResource{}
+	_ resource.ResourceWithModifyPlan  = &projectIntegrationExtractValueResource{}
 )
 
 func NewProjectIntegrationExtractValueResource() resource.Resource {
@@ -34,16 +35,16 @@ func (r *projectIntegrationExtractValueResource) Configure(_ context.Context, re
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectIntegrationExtractValueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,7 +131,8 @@ func getExtractValueByName(client *apiclient.SemaphoreUI, projectID int64, integ
 
 func (r *projectIntegrationExtractValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectIntegrationExtractValueModel
+	var plan ProjectIntegrationExtractValueResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,7 +141,7 @@ func (r *projectIntegrationExtractValueResource) Create(ctx context.Context, req
 	_, err := r.client.Project.PostProjectProjectIDIntegrationsIntegrationIDValues(&project.PostProjectProjectIDIntegrationsIntegrationIDValuesParams{
 		ProjectID:                 plan.ProjectID.ValueInt64(),
 		IntegrationID:             plan.IntegrationID.ValueInt64(),
-		IntegrationExtractedValue: convertProjectIntegrationExtractValueModelToExtractValue(plan),
+		IntegrationExtractedValue: convertProjectIntegrationExtractValueModelToExtractValue(plan.ProjectIntegrationExtractValueModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -160,7 +162,8 @@ func (r *projectIntegrationExtractValueResource) Create(ctx context.Context, req
 	}
 
 	// Set state to fully populated data
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	plan.ProjectIntegrationExtractValueModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,7 +172,7 @@ func (r *projectIntegrationExtractValueResource) Create(ctx context.Context, req
 // Read refreshes the Terraform state with the latest data.
 func (r *projectIntegrationExtractValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectIntegrationExtractValueModel
+	var state ProjectIntegrationExtractValueResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,7 +188,8 @@ func (r *projectIntegrationExtractValueResource) Read(ctx context.Context, req r
 	}
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	state.ProjectIntegrationExtractValueModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -222,15 +226,8 @@ func (o *updateExtractValueOperation) WriteToRequest(r runtime.ClientRequest, re
 	return nil
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan ProjectIntegrationExtractValueModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+// updateIntegrationExtractValue updates the extract value of an integration.
+func updateIntegrationExtractValue(client *apiclient.SemaphoreUI, extractValue ProjectIntegrationExtractValueModel) error {
 	// Use custom operation because the API requires 'id' in the request body
 	// but the generated IntegrationExtractValueRequest model doesn't include it
 	op := &runtime.ClientOperation{
@@ -241,10 +238,10 @@ func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params: &updateExtractValueOperation{
-			projectID:      plan.ProjectID.ValueInt64(),
-			integrationID:  plan.IntegrationID.ValueInt64(),
-			extractValueID: plan.ID.ValueInt64(),
-			extractValue:   convertProjectIntegrationExtractValueModelToExtractValue(plan),
+			projectID:      extractValue.ProjectID.ValueInt64(),
+			integrationID:  extractValue.IntegrationID.ValueInt64(),
+			extractValueID: extractValue.ID.ValueInt64(),
+			extractValue:   convertProjectIntegrationExtractValueModelToExtractValue(extractValue),
 		},
 		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 			if response.Code() == 204 {
@@ -254,8 +251,21 @@ func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req
 		}),
 	}
 
-	_, err := r.client.Transport.Submit(op)
-	if err != nil {
+	_, err := client.Transport.Submit(op)
+	return err
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectIntegrationExtractValueResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := updateIntegrationExtractValue(r.client, plan.ProjectIntegrationExtractValueModel); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating SemaphoreUI Integration Extract Value",
 			"Could not update integration extract value, unexpected error: "+err.Error(),
@@ -272,15 +282,21 @@ func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	plan.ProjectIntegrationExtractValueModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan resolves the project name of the extract value.
+func (r *projectIntegrationExtractValueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+}
+
 func (r *projectIntegrationExtractValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectIntegrationExtractValueModel
+	var state ProjectIntegrationExtractValueResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -319,7 +335,7 @@ func (r *projectIntegrationExtractValueResource) ImportState(ctx context.Context
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectIntegrationExtractValueResourceModel{ProjectIntegrationExtractValueModel: *model})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &projectIntegrationMatcherResource{}
 	_ resource.ResourceWithConfigure   = &projectIntegrationMatcherResource{}
 	_ resource.ResourceWithImportState = &projectIntegrationMatcherResource{}
+	_ resource.ResourceWithModifyPlan  = &projectIntegrationMatcherResource{}
 )
 
 func NewProjectIntegrationMatcherResource() resource.Resource {
@@ -34,16 +35,16 @@ func (r *projectIntegrationMatcherResource) Configure(_ context.Context, req res
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectIntegrationMatcherResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,7 +131,8 @@ func getMatcherByName(client *apiclient.SemaphoreUI, projectID int64, integratio
 
 func (r *projectIntegrationMatcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectIntegrationMatcherModel
+	var plan ProjectIntegrationMatcherResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,7 +141,7 @@ func (r *projectIntegrationMatcherResource) Create(ctx context.Context, req reso
 	_, err := r.client.Project.PostProjectProjectIDIntegrationsIntegrationIDMatchers(&project.PostProjectProjectIDIntegrationsIntegrationIDMatchersParams{
 		ProjectID:          plan.ProjectID.ValueInt64(),
 		IntegrationID:      plan.IntegrationID.ValueInt64(),
-		IntegrationMatcher: convertProjectIntegrationMatcherModelToMatcher(plan),
+		IntegrationMatcher: convertProjectIntegrationMatcherModelToMatcher(plan.ProjectIntegrationMatcherModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -160,7 +162,8 @@ func (r *projectIntegrationMatcherResource) Create(ctx context.Context, req reso
 	}
 
 	// Set state to fully populated data
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	plan.ProjectIntegrationMatcherModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,7 +172,7 @@ func (r *projectIntegrationMatcherResource) Create(ctx context.Context, req reso
 // Read refreshes the Terraform state with the latest data.
 func (r *projectIntegrationMatcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectIntegrationMatcherModel
+	var state ProjectIntegrationMatcherResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -185,7 +188,8 @@ func (r *projectIntegrationMatcherResource) Read(ctx context.Context, req resour
 	}
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	state.ProjectIntegrationMatcherModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -222,15 +226,8 @@ func (o *updateMatcherOperation) WriteToRequest(r runtime.ClientRequest, reg str
 	return nil
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan ProjectIntegrationMatcherModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+// updateIntegrationMatcher updates the matcher of an integration.
+func updateIntegrationMatcher(client *apiclient.SemaphoreUI, matcher ProjectIntegrationMatcherModel) error {
 	// Use custom operation because the API requires 'id' in the request body
 	// but the generated IntegrationMatcherRequest model doesn't include it
 	op := &runtime.ClientOperation{
@@ -241,10 +238,10 @@ func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req reso
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params: &updateMatcherOperation{
-			projectID:     plan.ProjectID.ValueInt64(),
-			integrationID: plan.IntegrationID.ValueInt64(),
-			matcherID:     plan.ID.ValueInt64(),
-			matcher:       convertProjectIntegrationMatcherModelToMatcher(plan),
+			projectID:     matcher.ProjectID.ValueInt64(),
+			integrationID: matcher.IntegrationID.ValueInt64(),
+			matcherID:     matcher.ID.ValueInt64(),
+			matcher:       convertProjectIntegrationMatcherModelToMatcher(matcher),
 		},
 		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 			if response.Code() == 204 {
@@ -254,8 +251,21 @@ func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req reso
 		}),
 	}
 
-	_, err := r.client.Transport.Submit(op)
-	if err != nil {
+	_, err := client.Transport.Submit(op)
+	return err
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectIntegrationMatcherResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := updateIntegrationMatcher(r.client, plan.ProjectIntegrationMatcherModel); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating SemaphoreUI Integration Matcher",
 			"Could not update integration matcher, unexpected error: "+err.Error(),
@@ -272,15 +282,21 @@ func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	plan.ProjectIntegrationMatcherModel = *model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan resolves the project name of the matcher.
+func (r *projectIntegrationMatcherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+}
+
 func (r *projectIntegrationMatcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectIntegrationMatcherModel
+	var state ProjectIntegrationMatcherResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -319,7 +335,7 @@ func (r *projectIntegrationMatcherResource) ImportState(ctx context.Context, req
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectIntegrationMatcherResourceModel{ProjectIntegrationMatcherModel: *model})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +25,11 @@ type (
 		Key           types.String `tfsdk:"key"`
 		Value         types.String `tfsdk:"value"`
 	}
+
+	ProjectIntegrationMatcherResourceModel struct {
+		ProjectIntegrationMatcherModel
+		ProjectName types.String `tfsdk:"project_name"`
+	}
 )
 
 func ProjectIntegrationMatcherSchema() superschema.Schema {
@@ -52,10 +59,26 @@ func ProjectIntegrationMatcherSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the integration belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the integration belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"integration_id": superschema.Int64Attribute{
@@ -1,23 +1,31 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"terraform-provider-semaphoreui/semaphoreui/models"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &projectIntegrationResource{}
-	_ resource.ResourceWithConfigure   = &projectIntegrationResource{}
-	_ resource.ResourceWithImportState = &projectIntegrationResource{}
+	_ resource.Resource                     = &projectIntegrationResource{}
+	_ resource.ResourceWithConfigure        = &projectIntegrationResource{}
+	_ resource.ResourceWithImportState      = &projectIntegrationResource{}
+	_ resource.ResourceWithModifyPlan       = &projectIntegrationResource{}
+	_ resource.ResourceWithConfigValidators = &projectIntegrationResource{}
+	_ resource.ResourceWithValidateConfig   = &projectIntegrationResource{}
 )
 
 func NewProjectIntegrationResource() resource.Resource {
@@ -25,7 +33,8 @@ func NewProjectIntegrationResource() resource.Resource {
 }
 
 type projectIntegrationResource struct {
-	client *apiclient.SemaphoreUI
+	client  *apiclient.SemaphoreUI
+	baseURL string
 }
 
 func (r *projectIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -33,16 +42,17 @@ func (r *projectIntegrationResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
+	r.baseURL = data.BaseURL
 }
 
 func (r *projectIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +63,127 @@ func (r *projectIntegrationResource) Schema(ctx context.Context, _ resource.Sche
 	resp.Schema = ProjectIntegrationSchema().GetResource(ctx)
 }
 
+func (r *projectIntegrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("auth_secret_id"),
+			path.MatchRoot("auth_secret"),
+		),
+	}
+}
+
+// ValidateConfig checks that an auth secret is set when, and only when, an auth method is set.
+func (r *projectIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectIntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.AuthMethod.IsUnknown() || config.AuthSecretID.IsUnknown() {
+		return
+	}
+
+	hasSecret := !config.AuthSecretID.IsNull() || config.AuthSecret != nil
+	if config.AuthMethod.IsNull() {
+		if hasSecret {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_method"),
+				"Missing Integration Auth Method",
+				"The auth_secret_id and auth_secret attributes can only be set when auth_method is set.",
+			)
+		}
+		return
+	}
+
+	if !hasSecret {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_method"),
+			"Missing Integration Auth Secret",
+			fmt.Sprintf("The %q auth method requires either auth_secret_id or auth_secret to be set.", config.AuthMethod.ValueString()),
+		)
+	}
+	if config.AuthMethod.ValueString() == "basic" && config.AuthSecret != nil && config.AuthSecret.Login.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_secret").AtName("login"),
+			"Missing Integration Auth Secret Login",
+			"The \"basic\" auth method requires the auth_secret login to be set.",
+		)
+	}
+}
+
+// validateIntegrationAuthKey checks that the key of the auth secret is a login_password key, as required by all the
+// auth methods. Keys that do not exist yet are checked by the API when the integration is created, and nothing is
+// checked before the provider is configured.
+func validateIntegrationAuthKey(client *apiclient.SemaphoreUI, plan ProjectIntegrationResourceModel) error {
+	if client == nil {
+		return nil
+	}
+	response, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{
+		ProjectID: plan.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("could not read project keys: %s", err.Error())
+	}
+	for _, key := range response.Payload {
+		if key.ID == plan.AuthSecretID.ValueInt64() && key.Type != ProjectKeyTypeLoginPassword {
+			return fmt.Errorf("the %q auth method requires a %s key, but key %q is a %s key", plan.AuthMethod.ValueString(), ProjectKeyTypeLoginPassword, key.Name, key.Type)
+		}
+	}
+	return nil
+}
+
+// convertProjectIntegrationAuthSecretToAccessKeyRequest returns the project key managed for the auth secret of the
+// integration.
+func convertProjectIntegrationAuthSecretToAccessKeyRequest(integration ProjectIntegrationResourceModel) *models.AccessKeyRequest {
+	return &models.AccessKeyRequest{
+		ProjectID: integration.ProjectID.ValueInt64(),
+		Name:      integration.Name.ValueString() + " auth secret",
+		Type:      ProjectKeyTypeLoginPassword,
+		LoginPassword: &models.AccessKeyRequestLoginPassword{
+			Login:    integration.AuthSecret.Login.ValueString(),
+			Password: integration.AuthSecret.Password.ValueString(),
+		},
+	}
+}
+
+// createIntegrationAuthKey creates the project key of the auth secret and sets the auth secret ID of the integration.
+func (r *projectIntegrationResource) createIntegrationAuthKey(integration *ProjectIntegrationResourceModel) error {
+	response, err := r.client.Project.PostProjectProjectIDKeys(&project.PostProjectProjectIDKeysParams{
+		ProjectID: integration.ProjectID.ValueInt64(),
+		AccessKey: convertProjectIntegrationAuthSecretToAccessKeyRequest(*integration),
+	}, nil)
+	if err != nil {
+		return err
+	}
+	integration.AuthSecretID = types.Int64Value(response.Payload.ID)
+	return nil
+}
+
+// updateIntegrationAuthKey updates the project key of the auth secret when the secret or the integration name changed.
+func (r *projectIntegrationResource) updateIntegrationAuthKey(plan ProjectIntegrationResourceModel, state ProjectIntegrationResourceModel) error {
+	if plan.Name.Equal(state.Name) && plan.AuthSecret.Login.Equal(state.AuthSecret.Login) && plan.AuthSecret.Password.Equal(state.AuthSecret.Password) {
+		return nil
+	}
+
+	key := convertProjectIntegrationAuthSecretToAccessKeyRequest(plan)
+	key.ID = plan.AuthSecretID.ValueInt64()
+	key.OverrideSecret = true
+	// The API only updates access keys when all the secret fields are set
+	key.SSH = &models.AccessKeyRequestSSH{}
+	_, err := r.client.Project.PutProjectProjectIDKeysKeyID(&project.PutProjectProjectIDKeysKeyIDParams{
+		ProjectID: plan.ProjectID.ValueInt64(),
+		KeyID:     plan.AuthSecretID.ValueInt64(),
+		AccessKey: key,
+	}, nil)
+	return err
+}
+
+// deleteIntegrationAuthKey removes the project key of the auth secret.
+func (r *projectIntegrationResource) deleteIntegrationAuthKey(projectID int64, keyID int64) error {
+	_, err := r.client.Project.DeleteProjectProjectIDKeysKeyID(&project.DeleteProjectProjectIDKeysKeyIDParams{
+		ProjectID: projectID,
+		KeyID:     keyID,
+	}, nil)
+	return err
+}
+
 func convertProjectIntegrationModelToIntegrationRequest(integration ProjectIntegrationModel) *models.IntegrationRequest {
 	return &models.IntegrationRequest{
 		ProjectID:    integration.ProjectID.ValueInt64(),
@@ -109,6 +240,260 @@ func convertIntegrationResponseToProjectIntegrationModel(response *models.Integr
 	return model
 }
 
+// setIntegrationAlias sets the alias and webhook URL of the integration from its first alias, creating an alias when
+// the integration has none and create is set. Searchable integrations are triggered through the project aliases,
+// their alias and webhook URL are null. Only the resource reads the aliases, the data source does not require the
+// permission to list them.
+func setIntegrationAlias(client *apiclient.SemaphoreUI, baseURL string, model *ProjectIntegrationResourceModel, create bool) error {
+	model.Alias = types.StringNull()
+	model.WebhookURL = types.StringNull()
+	if model.Searchable.ValueBool() {
+		return nil
+	}
+
+	aliases, err := getIntegrationAliases(client, model.ProjectID.ValueInt64(), model.ID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		if !create {
+			return nil
+		}
+		alias, err := createIntegrationAlias(client, model.ProjectID.ValueInt64(), model.ID.ValueInt64())
+		if err != nil {
+			return err
+		}
+		aliases = append(aliases, alias)
+	}
+	slices.SortFunc(aliases, func(a, b *models.IntegrationAlias) int { return cmp.Compare(a.ID, b.ID) })
+
+	model.Alias = types.StringValue(integrationAliasName(aliases[0]))
+	model.WebhookURL = types.StringValue(integrationWebhookURL(baseURL, integrationAliasName(aliases[0])))
+	return nil
+}
+
+func convertProjectIntegrationInlineMatcherToMatcherModel(matcher ProjectIntegrationInlineMatcherModel, projectID int64, integrationID int64) ProjectIntegrationMatcherModel {
+	return ProjectIntegrationMatcherModel{
+		ProjectID:     types.Int64Value(projectID),
+		IntegrationID: types.Int64Value(integrationID),
+		Name:          matcher.Name,
+		MatchType:     matcher.MatchType,
+		Method:        matcher.Method,
+		BodyDataType:  matcher.BodyDataType,
+		Key:           matcher.Key,
+		Value:         matcher.Value,
+	}
+}
+
+func convertIntegrationMatcherResponseToInlineMatcherModel(response *models.IntegrationMatcher) ProjectIntegrationInlineMatcherModel {
+	return ProjectIntegrationInlineMatcherModel{
+		Name:         types.StringValue(response.Name),
+		MatchType:    types.StringValue(response.MatchType),
+		Method:       types.StringValue(response.Method),
+		BodyDataType: types.StringValue(response.BodyDataType),
+		Key:          types.StringValue(response.Key),
+		Value:        types.StringValue(response.Value),
+	}
+}
+
+func convertProjectIntegrationInlineExtractValueToExtractValueModel(extractValue ProjectIntegrationInlineExtractValueModel, projectID int64, integrationID int64) ProjectIntegrationExtractValueModel {
+	return ProjectIntegrationExtractValueModel{
+		ProjectID:     types.Int64Value(projectID),
+		IntegrationID: types.Int64Value(integrationID),
+		Name:          extractValue.Name,
+		ValueSource:   extractValue.ValueSource,
+		BodyDataType:  extractValue.BodyDataType,
+		Key:           extractValue.Key,
+		Variable:      extractValue.Variable,
+		VariableType:  extractValue.VariableType,
+	}
+}
+
+func convertIntegrationExtractValueResponseToInlineExtractValueModel(response *models.IntegrationExtractValue) ProjectIntegrationInlineExtractValueModel {
+	return ProjectIntegrationInlineExtractValueModel{
+		Name:         types.StringValue(response.Name),
+		ValueSource:  types.StringValue(response.ValueSource),
+		BodyDataType: types.StringValue(response.BodyDataType),
+		Key:          types.StringValue(response.Key),
+		Variable:     types.StringValue(response.Variable),
+		VariableType: types.StringValue(response.VariableType),
+	}
+}
+
+// getIntegrationMatchers returns all the matchers of the integration.
+func getIntegrationMatchers(client *apiclient.SemaphoreUI, projectID int64, integrationID int64) ([]*models.IntegrationMatcher, error) {
+	response, err := client.Integration.GetProjectProjectIDIntegrationsIntegrationIDMatchers(&integration.GetProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+		ProjectID:     projectID,
+		IntegrationID: integrationID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read integration matchers: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+// getIntegrationExtractValues returns all the extract values of the integration.
+func getIntegrationExtractValues(client *apiclient.SemaphoreUI, projectID int64, integrationID int64) ([]*models.IntegrationExtractValue, error) {
+	response, err := client.Integration.GetProjectProjectIDIntegrationsIntegrationIDValues(&integration.GetProjectProjectIDIntegrationsIntegrationIDValuesParams{
+		ProjectID:     projectID,
+		IntegrationID: integrationID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read integration extract values: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+// setIntegrationMatchers makes the matchers of the integration match the planned matchers. Matchers that are already
+// defined are kept, the others are updated in place when there are matchers left to remove, and created otherwise.
+func setIntegrationMatchers(client *apiclient.SemaphoreUI, projectID int64, integrationID int64, planned []ProjectIntegrationInlineMatcherModel) error {
+	existing, err := getIntegrationMatchers(client, projectID, integrationID)
+	if err != nil {
+		return err
+	}
+
+	var missing []ProjectIntegrationInlineMatcherModel
+	for _, matcher := range planned {
+		index := slices.IndexFunc(existing, func(response *models.IntegrationMatcher) bool {
+			return convertIntegrationMatcherResponseToInlineMatcherModel(response) == matcher
+		})
+		if index < 0 {
+			missing = append(missing, matcher)
+			continue
+		}
+		existing = slices.Delete(existing, index, index+1)
+	}
+
+	for _, matcher := range missing {
+		model := convertProjectIntegrationInlineMatcherToMatcherModel(matcher, projectID, integrationID)
+		if len(existing) > 0 {
+			model.ID = types.Int64Value(existing[0].ID)
+			existing = existing[1:]
+			if err := updateIntegrationMatcher(client, model); err != nil {
+				return fmt.Errorf("could not update integration matcher %s: %s", matcher.Name.ValueString(), err.Error())
+			}
+			continue
+		}
+		_, err := client.Project.PostProjectProjectIDIntegrationsIntegrationIDMatchers(&project.PostProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+			ProjectID:          projectID,
+			IntegrationID:      integrationID,
+			IntegrationMatcher: convertProjectIntegrationMatcherModelToMatcher(model),
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not create integration matcher %s: %s", matcher.Name.ValueString(), err.Error())
+		}
+	}
+
+	for _, matcher := range existing {
+		_, err := client.Integration.DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID(&integration.DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDParams{
+			ProjectID:     projectID,
+			IntegrationID: integrationID,
+			MatcherID:     matcher.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not remove integration matcher %s: %s", matcher.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// setIntegrationExtractValues makes the extract values of the integration match the planned extract values, the
+// same way as setIntegrationMatchers.
+func setIntegrationExtractValues(client *apiclient.SemaphoreUI, projectID int64, integrationID int64, planned []ProjectIntegrationInlineExtractValueModel) error {
+	existing, err := getIntegrationExtractValues(client, projectID, integrationID)
+	if err != nil {
+		return err
+	}
+
+	var missing []ProjectIntegrationInlineExtractValueModel
+	for _, extractValue := range planned {
+		index := slices.IndexFunc(existing, func(response *models.IntegrationExtractValue) bool {
+			return convertIntegrationExtractValueResponseToInlineExtractValueModel(response) == extractValue
+		})
+		if index < 0 {
+			missing = append(missing, extractValue)
+			continue
+		}
+		existing = slices.Delete(existing, index, index+1)
+	}
+
+	for _, extractValue := range missing {
+		model := convertProjectIntegrationInlineExtractValueToExtractValueModel(extractValue, projectID, integrationID)
+		if len(existing) > 0 {
+			model.ID = types.Int64Value(existing[0].ID)
+			existing = existing[1:]
+			if err := updateIntegrationExtractValue(client, model); err != nil {
+				return fmt.Errorf("could not update integration extract value %s: %s", extractValue.Name.ValueString(), err.Error())
+			}
+			continue
+		}
+		_, err := client.Project.PostProjectProjectIDIntegrationsIntegrationIDValues(&project.PostProjectProjectIDIntegrationsIntegrationIDValuesParams{
+			ProjectID:                 projectID,
+			IntegrationID:             integrationID,
+			IntegrationExtractedValue: convertProjectIntegrationExtractValueModelToExtractValue(model),
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not create integration extract value %s: %s", extractValue.Name.ValueString(), err.Error())
+		}
+	}
+
+	for _, extractValue := range existing {
+		_, err := client.Integration.DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueID(&integration.DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDParams{
+			ProjectID:      projectID,
+			IntegrationID:  integrationID,
+			ExtractvalueID: extractValue.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not remove integration extract value %s: %s", extractValue.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// readIntegrationInlineValues sets the matchers and extract values of the integration when they are managed with
+// the integration, that is when they are not null in the state or plan.
+func readIntegrationInlineValues(client *apiclient.SemaphoreUI, model *ProjectIntegrationResourceModel) error {
+	projectID := model.ProjectID.ValueInt64()
+	integrationID := model.ID.ValueInt64()
+	if model.Matchers != nil {
+		matchers, err := getIntegrationMatchers(client, projectID, integrationID)
+		if err != nil {
+			return err
+		}
+		model.Matchers = make([]ProjectIntegrationInlineMatcherModel, 0, len(matchers))
+		for _, matcher := range matchers {
+			model.Matchers = append(model.Matchers, convertIntegrationMatcherResponseToInlineMatcherModel(matcher))
+		}
+	}
+	if model.ExtractValues != nil {
+		extractValues, err := getIntegrationExtractValues(client, projectID, integrationID)
+		if err != nil {
+			return err
+		}
+		model.ExtractValues = make([]ProjectIntegrationInlineExtractValueModel, 0, len(extractValues))
+		for _, extractValue := range extractValues {
+			model.ExtractValues = append(model.ExtractValues, convertIntegrationExtractValueResponseToInlineExtractValueModel(extractValue))
+		}
+	}
+	return nil
+}
+
+// setIntegrationInlineValues makes the matchers and extract values of the integration match the plan when they are
+// managed with the integration, and reads them back.
+func setIntegrationInlineValues(client *apiclient.SemaphoreUI, model *ProjectIntegrationResourceModel) error {
+	if model.Matchers != nil {
+		if err := setIntegrationMatchers(client, model.ProjectID.ValueInt64(), model.ID.ValueInt64(), model.Matchers); err != nil {
+			return err
+		}
+	}
+	if model.ExtractValues != nil {
+		if err := setIntegrationExtractValues(client, model.ProjectID.ValueInt64(), model.ID.ValueInt64(), model.ExtractValues); err != nil {
+			return err
+		}
+	}
+	return readIntegrationInlineValues(client, model)
+}
+
 // getIntegrationByID retrieves an integration by ID from the list of integrations.
 func getIntegrationByID(client *apiclient.SemaphoreUI, projectID int64, integrationID int64) (*ProjectIntegrationModel, error) {
 	response, err := client.Project.GetProjectProjectIDIntegrations(&project.GetProjectProjectIDIntegrationsParams{
@@ -145,24 +530,71 @@ func GetIntegrationByName(client *apiclient.SemaphoreUI, projectID int64, name s
 
 func (r *projectIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectIntegrationModel
+	var plan ProjectIntegrationResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The auth secret key is only checked on plan when its ID is known
+	if plan.AuthSecret == nil && !plan.AuthSecretID.IsNull() && !plan.AuthMethod.IsNull() {
+		if err := validateIntegrationAuthKey(r.client, plan); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_secret_id"),
+				"Invalid Integration Auth Secret",
+				"The auth secret key does not match the auth method: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.AuthSecret != nil {
+		if err := r.createIntegrationAuthKey(&plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating SemaphoreUI Project Integration Auth Secret",
+				"Could not create project key of the integration auth secret, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	response, err := r.client.Project.PostProjectProjectIDIntegrations(&project.PostProjectProjectIDIntegrationsParams{
 		ProjectID:   plan.ProjectID.ValueInt64(),
-		Integration: convertProjectIntegrationModelToIntegrationRequest(plan),
+		Integration: convertProjectIntegrationModelToIntegrationRequest(plan.ProjectIntegrationModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating SemaphoreUI Project Integration",
 			"Could not create project integration, unexpected error: "+err.Error(),
 		)
+		if plan.AuthSecret != nil {
+			// Do not leave the key of the auth secret behind, it is not in the state
+			_ = r.deleteIntegrationAuthKey(plan.ProjectID.ValueInt64(), plan.AuthSecretID.ValueInt64())
+		}
+		return
+	}
+	model := ProjectIntegrationResourceModel{
+		ProjectIntegrationModel: convertIntegrationResponseToProjectIntegrationModel(response.Payload),
+		ProjectName:             plan.ProjectName,
+		AuthSecret:              plan.AuthSecret,
+		Matchers:                plan.Matchers,
+		ExtractValues:           plan.ExtractValues,
+	}
+	if err := setIntegrationAlias(r.client, r.baseURL, &model, true); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration Alias",
+			"Could not create project integration alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err := setIntegrationInlineValues(r.client, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration",
+			"Could not set project integration matchers and extract values, unexpected error: "+err.Error(),
+		)
 		return
 	}
-	model := convertIntegrationResponseToProjectIntegrationModel(response.Payload)
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
@@ -174,13 +606,13 @@ func (r *projectIntegrationResource) Create(ctx context.Context, req resource.Cr
 // Read refreshes the Terraform state with the latest data.
 func (r *projectIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectIntegrationModel
+	var state ProjectIntegrationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model, err := getIntegrationByID(r.client, state.ProjectID.ValueInt64(), state.ID.ValueInt64())
+	integration, err := getIntegrationByID(r.client, state.ProjectID.ValueInt64(), state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SemaphoreUI Project Integration",
@@ -188,9 +620,31 @@ func (r *projectIntegrationResource) Read(ctx context.Context, req resource.Read
 		)
 		return
 	}
+	// SemaphoreUI API never returns secret values, so the auth secret is kept from the state
+	model := ProjectIntegrationResourceModel{
+		ProjectIntegrationModel: *integration,
+		ProjectName:             state.ProjectName,
+		AuthSecret:              state.AuthSecret,
+		Matchers:                state.Matchers,
+		ExtractValues:           state.ExtractValues,
+	}
+	if err := setIntegrationAlias(r.client, r.baseURL, &model, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration",
+			err.Error(),
+		)
+		return
+	}
+	if err := readIntegrationInlineValues(r.client, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration",
+			err.Error(),
+		)
+		return
+	}
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,13 +683,43 @@ func (o *updateIntegrationOperation) WriteToRequest(r runtime.ClientRequest, reg
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan ProjectIntegrationModel
+	// Retrieve values from plan and state
+	var plan, state ProjectIntegrationResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The auth secret key is only checked on plan when its ID is known
+	if plan.AuthSecret == nil && !plan.AuthSecretID.IsNull() && !plan.AuthMethod.IsNull() {
+		if err := validateIntegrationAuthKey(r.client, plan); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_secret_id"),
+				"Invalid Integration Auth Secret",
+				"The auth secret key does not match the auth method: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.AuthSecret != nil {
+		var err error
+		if state.AuthSecret == nil {
+			err = r.createIntegrationAuthKey(&plan)
+		} else {
+			err = r.updateIntegrationAuthKey(plan, state)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating SemaphoreUI Project Integration Auth Secret",
+				"Could not update project key of the integration auth secret, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Use custom operation because the API requires 'id' in the request body
 	// but the generated IntegrationRequest model doesn't include it
 	op := &runtime.ClientOperation{
@@ -248,7 +732,7 @@ func (r *projectIntegrationResource) Update(ctx context.Context, req resource.Up
 		Params: &updateIntegrationOperation{
 			projectID:     plan.ProjectID.ValueInt64(),
 			integrationID: plan.ID.ValueInt64(),
-			integration:   convertProjectIntegrationModelToIntegration(plan),
+			integration:   convertProjectIntegrationModelToIntegration(plan.ProjectIntegrationModel),
 		},
 		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 			if response.Code() == 204 {
@@ -267,7 +751,18 @@ func (r *projectIntegrationResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	model, err := getIntegrationByID(r.client, plan.ProjectID.ValueInt64(), plan.ID.ValueInt64())
+	// The key of the auth secret can only be removed once the integration no longer uses it
+	if state.AuthSecret != nil && plan.AuthSecret == nil {
+		if err := r.deleteIntegrationAuthKey(state.ProjectID.ValueInt64(), state.AuthSecretID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing SemaphoreUI Project Integration Auth Secret",
+				"Could not remove project key of the integration auth secret, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	integration, err := getIntegrationByID(r.client, plan.ProjectID.ValueInt64(), plan.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SemaphoreUI Project Integration",
@@ -275,16 +770,94 @@ func (r *projectIntegrationResource) Update(ctx context.Context, req resource.Up
 		)
 		return
 	}
+	model := ProjectIntegrationResourceModel{
+		ProjectIntegrationModel: *integration,
+		ProjectName:             plan.ProjectName,
+		AuthSecret:              plan.AuthSecret,
+		Matchers:                plan.Matchers,
+		ExtractValues:           plan.ExtractValues,
+	}
+	if err := setIntegrationAlias(r.client, r.baseURL, &model, true); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration Alias",
+			"Could not create project integration alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err := setIntegrationInlineValues(r.client, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI Project Integration",
+			"Could not set project integration matchers and extract values, unexpected error: "+err.Error(),
+		)
+		return
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan plans the ID of the key managed for the auth secret, and checks the type of the auth secret key. It also
+// marks the alias and webhook URL unknown when they change on update: when the integration is made searchable or
+// not searchable, or when its alias has been removed outside of Terraform and is recreated. The project name is
+// resolved first.
+func (r *projectIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, config ProjectIntegrationResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *ProjectIntegrationResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &ProjectIntegrationResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if config.AuthSecretID.IsNull() {
+		authSecretID := types.Int64Null()
+		if plan.AuthSecret != nil {
+			authSecretID = types.Int64Unknown()
+			if state != nil && state.AuthSecret != nil {
+				authSecretID = state.AuthSecretID
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("auth_secret_id"), authSecretID)...)
+	} else if !config.AuthSecretID.IsUnknown() && !plan.ProjectID.IsUnknown() && !plan.AuthMethod.IsNull() {
+		if err := validateIntegrationAuthKey(r.client, plan); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_secret_id"),
+				"Invalid Integration Auth Secret",
+				"The auth secret key does not match the auth method: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if state == nil {
+		return
+	}
+	if plan.Searchable.IsUnknown() || !plan.Searchable.Equal(state.Searchable) || (!plan.Searchable.ValueBool() && state.Alias.IsNull()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("alias"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("webhook_url"), types.StringUnknown())...)
+	}
+}
+
 func (r *projectIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectIntegrationModel
+	var state ProjectIntegrationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -301,6 +874,16 @@ func (r *projectIntegrationResource) Delete(ctx context.Context, req resource.De
 		)
 		return
 	}
+
+	if state.AuthSecret != nil {
+		if err := r.deleteIntegrationAuthKey(state.ProjectID.ValueInt64(), state.AuthSecretID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing SemaphoreUI Project Integration Auth Secret",
+				"Could not remove project key of the integration auth secret, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
 }
 
 func (r *projectIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -313,7 +896,7 @@ func (r *projectIntegrationResource) ImportState(ctx context.Context, req resour
 		return
 	}
 
-	model, err := getIntegrationByID(r.client, fields["project"], fields["integration"])
+	integration, err := getIntegrationByID(r.client, fields["project"], fields["integration"])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SemaphoreUI Project Integration",
@@ -321,8 +904,9 @@ func (r *projectIntegrationResource) ImportState(ctx context.Context, req resour
 		)
 		return
 	}
+	model := ProjectIntegrationResourceModel{ProjectIntegrationModel: *integration}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
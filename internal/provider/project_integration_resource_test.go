@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -167,6 +168,8 @@ func TestAcc_ProjectIntegrationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "project_id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "template_id"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "alias"),
+					resource.TestMatchResourceAttr("semaphoreui_project_integration.test", "webhook_url", regexp.MustCompile(`/api/integrations/\w+$`)),
 				),
 			},
 			// ImportState testing
@@ -214,6 +217,8 @@ func TestAcc_ProjectIntegrationResource_withTokenAuth(t *testing.T) {
 					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_header", "X-Webhook-Token"),
 					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "searchable", "true"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "auth_secret_id"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_integration.test", "alias"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_integration.test", "webhook_url"),
 				),
 			},
 			// Update to github auth
@@ -236,3 +241,209 @@ func TestAcc_ProjectIntegrationResource_withTokenAuth(t *testing.T) {
 		},
 	})
 }
+
+func testAccProjectIntegrationWithAuthSecretConfig(nameSuffix string, authMethod string, authSecret string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_integration" "test" {
+  project_id  = semaphoreui_project.test.id
+  name        = "Auth Secret Integration %[2]s"
+  template_id = semaphoreui_project_template.test.id
+  searchable  = true
+  auth_method = "%[3]s"
+  auth_secret = %[4]s
+}
+`, testAccProjectIntegrationWithAuthDependencyConfig(nameSuffix), nameSuffix, authMethod, authSecret)
+}
+
+func TestAcc_ProjectIntegrationResource_withAuthSecret(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a managed basic auth secret
+			{
+				Config: testAccProjectIntegrationWithAuthSecretConfig(nameSuffix, "basic", `{
+    login    = "webhook"
+    password = "secret"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_method", "basic"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_secret.login", "webhook"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_secret.password", "secret"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "auth_secret_id"),
+				),
+			},
+			// Update the secret, the managed key is kept
+			{
+				Config: testAccProjectIntegrationWithAuthSecretConfig(nameSuffix, "hmac", `{
+    password = "new-secret"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_method", "hmac"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_integration.test", "auth_secret.login"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "auth_secret.password", "new-secret"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration.test", "auth_secret_id"),
+				),
+			},
+			// Switch to an existing key, the managed key is removed
+			{
+				Config: testAccProjectIntegrationWithGithubAuthConfig(nameSuffix, fmt.Sprintf("GitHub Auth Integration %s", nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_integration.test", "auth_secret"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_integration.test", "auth_secret_id", "semaphoreui_project_key.auth_secret", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectIntegrationWithInlineValuesConfig(nameSuffix string, matchers string, extractValues string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_integration" "test" {
+  project_id     = semaphoreui_project.test.id
+  name           = "Inline Integration %[2]s"
+  template_id    = semaphoreui_project_template.test.id
+  searchable     = true
+  matchers       = %[3]s
+  extract_values = %[4]s
+}
+`, testAccProjectIntegrationDependencyConfig(nameSuffix), nameSuffix, matchers, extractValues)
+}
+
+func TestAcc_ProjectIntegrationResource_inlineValues(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with matchers and extract values
+			{
+				Config: testAccProjectIntegrationWithInlineValuesConfig(nameSuffix, `[
+    {
+      name           = "Push"
+      match_type     = "header"
+      method         = "equals"
+      body_data_type = "string"
+      key            = "X-GitHub-Event"
+      value          = "push"
+    },
+    {
+      name           = "Main Branch"
+      match_type     = "body"
+      method         = "equals"
+      body_data_type = "json"
+      key            = "ref"
+      value          = "refs/heads/main"
+    },
+  ]`, `[
+    {
+      name           = "Commit"
+      value_source   = "body"
+      body_data_type = "json"
+      key            = "after"
+      variable       = "COMMIT"
+      variable_type  = "environment"
+    },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "matchers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_integration.test", "matchers.*", map[string]string{
+						"name":  "Main Branch",
+						"key":   "ref",
+						"value": "refs/heads/main",
+					}),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "extract_values.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_integration.test", "extract_values.*", map[string]string{
+						"name":     "Commit",
+						"variable": "COMMIT",
+					}),
+				),
+			},
+			// Replace a matcher, the extract values are no longer managed
+			{
+				Config: testAccProjectIntegrationWithInlineValuesConfig(nameSuffix, `[
+    {
+      name           = "Push"
+      match_type     = "header"
+      method         = "equals"
+      body_data_type = "string"
+      key            = "X-GitHub-Event"
+      value          = "push"
+    },
+    {
+      name           = "Release Branch"
+      match_type     = "body"
+      method         = "contains"
+      body_data_type = "json"
+      key            = "ref"
+      value          = "release"
+    },
+  ]`, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "matchers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_integration.test", "matchers.*", map[string]string{
+						"name":   "Release Branch",
+						"method": "contains",
+					}),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_integration.test", "extract_values"),
+				),
+			},
+			// Remove all the matchers
+			{
+				Config: testAccProjectIntegrationWithInlineValuesConfig(nameSuffix, "[]", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExists("semaphoreui_project_integration.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "matchers.#", "0"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration.test", "extract_values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectIntegrationResource_invalidAuth(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectIntegrationDependencyConfig(nameSuffix) + `
+resource "semaphoreui_project_integration" "test" {
+  project_id  = semaphoreui_project.test.id
+  name        = "Invalid"
+  template_id = semaphoreui_project_template.test.id
+  auth_method = "token"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Integration Auth Secret"),
+			},
+			{
+				Config: testAccProjectIntegrationWithAuthSecretConfig(nameSuffix, "basic", `{
+    password = "secret"
+  }`),
+				ExpectError: regexp.MustCompile("Missing Integration Auth Secret Login"),
+			},
+			{
+				Config: testAccProjectIntegrationDependencyConfig(nameSuffix) + `
+resource "semaphoreui_project_integration" "test" {
+  project_id     = semaphoreui_project.test.id
+  name           = "Invalid"
+  template_id    = semaphoreui_project_template.test.id
+  auth_method    = "github"
+  auth_secret_id = semaphoreui_project_key.test.id
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Integration Auth Secret"),
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
@@ -25,6 +26,39 @@ type (
 		AuthSecretID types.Int64  `tfsdk:"auth_secret_id"`
 		AuthHeader   types.String `tfsdk:"auth_header"`
 	}
+
+	ProjectIntegrationResourceModel struct {
+		ProjectIntegrationModel
+		ProjectName   types.String                                `tfsdk:"project_name"`
+		Alias         types.String                                `tfsdk:"alias"`
+		WebhookURL    types.String                                `tfsdk:"webhook_url"`
+		AuthSecret    *ProjectIntegrationAuthSecretModel          `tfsdk:"auth_secret"`
+		Matchers      []ProjectIntegrationInlineMatcherModel      `tfsdk:"matchers"`
+		ExtractValues []ProjectIntegrationInlineExtractValueModel `tfsdk:"extract_values"`
+	}
+
+	ProjectIntegrationAuthSecretModel struct {
+		Login    types.String `tfsdk:"login"`
+		Password types.String `tfsdk:"password"`
+	}
+
+	ProjectIntegrationInlineMatcherModel struct {
+		Name         types.String `tfsdk:"name"`
+		MatchType    types.String `tfsdk:"match_type"`
+		Method       types.String `tfsdk:"method"`
+		BodyDataType types.String `tfsdk:"body_data_type"`
+		Key          types.String `tfsdk:"key"`
+		Value        types.String `tfsdk:"value"`
+	}
+
+	ProjectIntegrationInlineExtractValueModel struct {
+		Name         types.String `tfsdk:"name"`
+		ValueSource  types.String `tfsdk:"value_source"`
+		BodyDataType types.String `tfsdk:"body_data_type"`
+		Key          types.String `tfsdk:"key"`
+		Variable     types.String `tfsdk:"variable"`
+		VariableType types.String `tfsdk:"variable_type"`
+	}
 )
 
 func ProjectIntegrationSchema() superschema.Schema {
@@ -61,10 +95,26 @@ func ProjectIntegrationSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the integration belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the integration belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"name": superschema.StringAttribute{
@@ -125,15 +175,147 @@ func ProjectIntegrationSchema() superschema.Schema {
 			},
 			"auth_secret_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
-					MarkdownDescription: "The ID of the project key containing the secret used for authentication. Required when `auth_method` is set.",
+					MarkdownDescription: "The ID of the project key containing the secret used for authentication.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					Optional: true,
+					MarkdownDescription: "The key must be a `login_password` key, the `password` is the token, or the HMAC secret, and the `login` is the username of the `basic` authentication method. Either `auth_secret_id` or `auth_secret` is required when `auth_method` is set. Set to the ID of the managed key when `auth_secret` is set.",
+					Optional:            true,
+					Computed:            true,
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"auth_secret": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The secret used for authentication, stored in a `login_password` project key managed with the integration. The key is created, updated, and removed with the integration. Conflicts with `auth_secret_id`.",
+					Optional:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"login": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The username of the `basic` authentication method. Required when `auth_method` is `basic`.",
+							Optional:            true,
+						},
+					},
+					"password": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The token, the HMAC secret, or the password of the `basic` authentication method.",
+							Required:            true,
+							Sensitive:           true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"matchers": superschema.SetNestedAttribute{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The matchers of the integration, managed with the integration. When set, matchers of the integration that are not in the set are removed, and an empty set removes all of them. When not set, the matchers are not managed, for example with `semaphoreui_project_integration_matcher` resources. Do not use both for the same integration.",
+					Optional:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The display name of the matcher.",
+							Required:            true,
+						},
+					},
+					"match_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Where to look for the match. Valid values are `body` or `header`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("body", "header"),
+							},
+						},
+					},
+					"method": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The comparison method. Valid values are `equals`, `unequals`, or `contains`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("equals", "unequals", "contains"),
+							},
+						},
+					},
+					"body_data_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The data type of the body. Valid values are `json`, `xml`, or `string`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("json", "xml", "string"),
+							},
+						},
+					},
+					"key": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The key to match in the body or header.",
+							Required:            true,
+						},
+					},
+					"value": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The value to match against.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"extract_values": superschema.SetNestedAttribute{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The extract values of the integration, managed with the integration. When set, extract values of the integration that are not in the set are removed, and an empty set removes all of them. When not set, the extract values are not managed, for example with `semaphoreui_project_integration_extract_value` resources. Do not use both for the same integration.",
+					Optional:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The display name of the extract value.",
+							Required:            true,
+						},
+					},
+					"value_source": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Where to extract the value from. Valid values are `body` or `header`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("body", "header"),
+							},
+						},
+					},
+					"body_data_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The data type of the body. Valid values are `json`, `xml`, or `string`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("json", "xml", "string"),
+							},
+						},
+					},
+					"key": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The key to extract from the body or header.",
+							Required:            true,
+						},
+					},
+					"variable": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The variable name to store the extracted value.",
+							Required:            true,
+						},
+					},
+					"variable_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of variable to set. Valid values are `environment` or `task`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("environment", "task"),
+							},
+						},
+					},
+				},
+			},
 			"auth_header": superschema.StringAttribute{
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The custom header name for authentication (e.g., `X-Webhook-Token`). Used with `token` authentication method.",
@@ -145,6 +327,20 @@ func ProjectIntegrationSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"alias": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The dedicated alias of the integration, generated by SemaphoreUI. An alias is created with the integration when it does not have one. Null for searchable integrations, which are triggered through the project aliases (see `semaphoreui_project_integration_alias`).",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+			"webhook_url": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The URL of the webhook endpoint of the integration `alias`, built from the provider `api_base_url`. Configure it as the webhook URL in GitHub, Bitbucket, or other systems sending webhooks. Null for searchable integrations.",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
 		},
 	}
 }
@@ -17,6 +17,7 @@ var (
 	_ resource.ResourceWithConfigure        = &projectInventoryResource{}
 	_ resource.ResourceWithImportState      = &projectInventoryResource{}
 	_ resource.ResourceWithConfigValidators = &projectInventoryResource{}
+	_ resource.ResourceWithModifyPlan       = &projectInventoryResource{}
 )
 
 func NewProjectInventoryResource() resource.Resource {
@@ -32,16 +33,16 @@ func (r *projectInventoryResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 // Metadata returns the resource type name.
@@ -146,7 +147,8 @@ func convertInventoryResponseToProjectInventoryModel(inventory *models.Inventory
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectInventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ProjectInventoryModel
+	var plan ProjectInventoryResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectInventoryNameReferences...)...)
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -155,7 +157,7 @@ func (r *projectInventoryResource) Create(ctx context.Context, req resource.Crea
 
 	response, err := r.client.Project.PostProjectProjectIDInventory(&project.PostProjectProjectIDInventoryParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		Inventory: convertProjectInventoryModelToInventoryRequest(plan),
+		Inventory: convertProjectInventoryModelToInventoryRequest(plan.ProjectInventoryModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -164,7 +166,7 @@ func (r *projectInventoryResource) Create(ctx context.Context, req resource.Crea
 		)
 		return
 	}
-	plan = convertInventoryResponseToProjectInventoryModel(response.Payload)
+	plan.ProjectInventoryModel = convertInventoryResponseToProjectInventoryModel(response.Payload)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, &plan)
@@ -177,7 +179,7 @@ func (r *projectInventoryResource) Create(ctx context.Context, req resource.Crea
 // Read refreshes the Terraform state with the latest data.
 func (r *projectInventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectInventoryModel
+	var state ProjectInventoryResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -195,7 +197,7 @@ func (r *projectInventoryResource) Read(ctx context.Context, req resource.ReadRe
 		)
 		return
 	}
-	state = convertInventoryResponseToProjectInventoryModel(response.Payload)
+	state.ProjectInventoryModel = convertInventoryResponseToProjectInventoryModel(response.Payload)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -208,7 +210,8 @@ func (r *projectInventoryResource) Read(ctx context.Context, req resource.ReadRe
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectInventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectInventoryModel
+	var plan ProjectInventoryResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectInventoryNameReferences...)...)
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -218,7 +221,7 @@ func (r *projectInventoryResource) Update(ctx context.Context, req resource.Upda
 	_, err := r.client.Project.PutProjectProjectIDInventoryInventoryID(&project.PutProjectProjectIDInventoryInventoryIDParams{
 		ProjectID:   plan.ProjectID.ValueInt64(),
 		InventoryID: plan.ID.ValueInt64(),
-		Inventory:   convertProjectInventoryModelToInventoryRequest(plan),
+		Inventory:   convertProjectInventoryModelToInventoryRequest(plan.ProjectInventoryModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -240,7 +243,7 @@ func (r *projectInventoryResource) Update(ctx context.Context, req resource.Upda
 		)
 		return
 	}
-	plan = convertInventoryResponseToProjectInventoryModel(response.Payload)
+	plan.ProjectInventoryModel = convertInventoryResponseToProjectInventoryModel(response.Payload)
 
 	// Update resource state with updated project
 	diags = resp.State.Set(ctx, plan)
@@ -250,10 +253,71 @@ func (r *projectInventoryResource) Update(ctx context.Context, req resource.Upda
 	}
 }
 
+// projectInventoryReferences returns the references of the inventory to the other objects of its project.
+func projectInventoryReferences(inventory *ProjectInventoryModel) []projectReference {
+	if inventory == nil {
+		return nil
+	}
+	references := []projectReference{
+		{path: path.Root("ssh_key_id"), kind: projectKeyKind, id: inventory.SSHKeyID},
+	}
+	if inventory.Static != nil {
+		references = append(references, projectReference{path: path.Root("static").AtName("become_key_id"), kind: projectKeyKind, id: inventory.Static.BecomeKeyID})
+	}
+	if inventory.StaticYaml != nil {
+		references = append(references, projectReference{path: path.Root("static_yaml").AtName("become_key_id"), kind: projectKeyKind, id: inventory.StaticYaml.BecomeKeyID})
+	}
+	if inventory.File != nil {
+		references = append(references,
+			projectReference{path: path.Root("file").AtName("repository_id"), kind: projectRepositoryKind, id: inventory.File.RepositoryID},
+			projectReference{path: path.Root("file").AtName("become_key_id"), kind: projectKeyKind, id: inventory.File.BecomeKeyID},
+		)
+	}
+	return references
+}
+
+// projectInventoryNameReferences are the name attributes of the inventory.
+var projectInventoryNameReferences = []projectNameReference{
+	projectNameReferenceOfProject,
+	{idPath: path.Root("ssh_key_id"), namePath: path.Root("ssh_key_name"), kind: projectKeyKind},
+}
+
+// ModifyPlan resolves the project and SSH key names of the inventory, and checks that the keys and repository of the
+// inventory belong to the project of the inventory.
+func (r *projectInventoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectInventoryNameReferences...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectInventoryResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *ProjectInventoryModel
+	stateProjectID := types.Int64Null()
+	if !req.State.Raw.IsNull() {
+		var stateModel ProjectInventoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state = &stateModel.ProjectInventoryModel
+		stateProjectID = state.ProjectID
+	}
+
+	resp.Diagnostics.Append(validateProjectReferences(r.client, plan.ProjectID, projectInventoryReferences(&plan.ProjectInventoryModel), stateProjectID, projectInventoryReferences(state))...)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *projectInventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectInventoryModel
+	var state ProjectInventoryResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -295,7 +359,7 @@ func (r *projectInventoryResource) ImportState(ctx context.Context, req resource
 		)
 		return
 	}
-	state := convertInventoryResponseToProjectInventoryModel(response.Payload)
+	state := ProjectInventoryResourceModel{ProjectInventoryModel: convertInventoryResponseToProjectInventoryModel(response.Payload)}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
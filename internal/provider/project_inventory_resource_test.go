@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"testing"
@@ -457,3 +458,42 @@ func TestAcc_ProjectInventoryResource_updateType(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_ProjectInventoryResource_otherProjectKey(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	other := fmt.Sprintf(`
+resource "semaphoreui_project" "other" {
+  name = "other-%[1]s"
+}
+resource "semaphoreui_project_key" "other" {
+  project_id = semaphoreui_project.other.id
+  name       = "other-%[1]s"
+  login_password = {
+    password = "password"
+  }
+}
+`, nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectInventoryEmptyConfig(nameSuffix, other),
+			},
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_inventory" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test %[2]s"
+  ssh_key_id = semaphoreui_project_key.test.id
+  static = {
+    inventory     = "localhost"
+    become_key_id = semaphoreui_project_key.other.id
+  }
+}`, testAccProjectInventoryEmptyConfig(nameSuffix, other), nameSuffix),
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Project Key"),
+			},
+		},
+	})
+}
@@ -26,6 +26,12 @@ type (
 		TerraformWorkspace *ProjectInventoryTerraformWorkspaceModel `tfsdk:"terraform_workspace"`
 	}
 
+	ProjectInventoryResourceModel struct {
+		ProjectInventoryModel
+		ProjectName types.String `tfsdk:"project_name"`
+		SSHKeyName  types.String `tfsdk:"ssh_key_name"`
+	}
+
 	ProjectInventoryStaticModel struct {
 		Inventory   types.String `tfsdk:"inventory"`
 		BecomeKeyID types.Int64  `tfsdk:"become_key_id"`
@@ -88,10 +94,26 @@ func ProjectInventorySchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the inventory belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the inventory belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"name": superschema.StringAttribute{
@@ -117,12 +139,25 @@ func ProjectInventorySchema() superschema.Schema {
 					MarkdownDescription: "The Project Key ID to use for accessing hosts in the inventory. This attribute is required for all inventory types in SemaphoreUI. You should set it to the ID of a Key of type `none` if the inventory doesn't require credentials, or for Workspace type inventories.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					Required: true,
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("ssh_key_id"),
+							path.MatchRoot("ssh_key_name"),
+						),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"ssh_key_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project key to use for accessing hosts in the inventory, instead of `ssh_key_id`.",
+					Optional:            true,
+				},
+			},
 			"static": superschema.SingleNestedAttribute{
 				Common: &schemaR.SingleNestedAttribute{
 					MarkdownDescription: "Static Inventory.",
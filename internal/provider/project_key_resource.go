@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"terraform-provider-semaphoreui/semaphoreui/models"
@@ -18,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure        = &projectKeyResource{}
 	_ resource.ResourceWithImportState      = &projectKeyResource{}
 	_ resource.ResourceWithConfigValidators = &projectKeyResource{}
+	_ resource.ResourceWithModifyPlan       = &projectKeyResource{}
 )
 
 func NewProjectKeyResource() resource.Resource {
@@ -33,16 +40,16 @@ func (r *projectKeyResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,6 +70,126 @@ func (r *projectKeyResource) ConfigValidators(ctx context.Context) []resource.Co
 	}
 }
 
+// generateSSHKeyPair generates a new SSH key pair, encrypting the private key when a passphrase is given.
+func generateSSHKeyPair(generate *ProjectKeySSHGenerateModel, passphrase string) (privateKey string, publicKey string, fingerprint string, err error) {
+	var private any
+	var public any
+	switch generate.Algorithm.ValueString() {
+	case ProjectKeySSHAlgorithmRSA:
+		key, err := rsa.GenerateKey(rand.Reader, int(generate.RSABits.ValueInt64()))
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public = key, &key.PublicKey
+	default:
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public = key, pub
+	}
+
+	var block *pem.Block
+	if passphrase != "" {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(private, "", []byte(passphrase))
+	} else {
+		block, err = ssh.MarshalPrivateKey(private, "")
+	}
+	if err != nil {
+		return "", "", "", err
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(public)
+	if err != nil {
+		return "", "", "", err
+	}
+	return string(pem.EncodeToMemory(block)), string(ssh.MarshalAuthorizedKey(sshPublicKey)), ssh.FingerprintSHA256(sshPublicKey), nil
+}
+
+// generatePlannedSSHKey generates the SSH key pair when the plan requires a new one.
+func generatePlannedSSHKey(key *ProjectKeyModel) error {
+	if key.SSH == nil || key.SSH.Generate == nil || !key.SSH.PrivateKey.IsUnknown() {
+		return nil
+	}
+	privateKey, publicKey, fingerprint, err := generateSSHKeyPair(key.SSH.Generate, key.SSH.Passphrase.ValueString())
+	if err != nil {
+		return err
+	}
+	key.SSH.PrivateKey = types.StringValue(privateKey)
+	key.SSH.PublicKeyOpenSSH = types.StringValue(publicKey)
+	key.SSH.Fingerprint = types.StringValue(fingerprint)
+	return nil
+}
+
+// ModifyPlan resolves the project name, plans a new SSH key pair when generate options change, and computes the public
+// key of a supplied private key.
+func (r *projectKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectKeyResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SSH == nil {
+		return
+	}
+
+	var stateSSH *ProjectKeySSH
+	if !req.State.Raw.IsNull() {
+		var state ProjectKeyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateSSH = state.SSH
+	}
+
+	sshPath := path.Root(ProjectKeyTypeSSH)
+	if plan.SSH.Generate != nil {
+		regenerate := stateSSH == nil || stateSSH.Generate == nil || *plan.SSH.Generate != *stateSSH.Generate ||
+			!plan.SSH.Passphrase.Equal(stateSSH.Passphrase)
+		if regenerate {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("private_key"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("public_key_openssh"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("fingerprint"), types.StringUnknown())...)
+		}
+		return
+	}
+
+	// The private key is supplied, so use the configured value rather than a previously generated one
+	var configPrivateKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, sshPath.AtName("private_key"), &configPrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	publicKey, fingerprint := sshPublicKeyOf(configPrivateKey, plan.SSH.Passphrase)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("private_key"), configPrivateKey)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("public_key_openssh"), publicKey)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, sshPath.AtName("fingerprint"), fingerprint)...)
+}
+
+// sshPublicKeyOf returns the public key and fingerprint of a supplied private key. Values are unknown until the
+// private key and passphrase are known, and null when the private key can not be parsed.
+func sshPublicKeyOf(privateKey types.String, passphrase types.String) (types.String, types.String) {
+	if privateKey.IsUnknown() || passphrase.IsUnknown() {
+		return types.StringUnknown(), types.StringUnknown()
+	}
+	if privateKey.IsNull() {
+		return types.StringNull(), types.StringNull()
+	}
+	signer, err := semaphorevalidator.ParseSSHPrivateKey(privateKey.ValueString(), passphrase.ValueString())
+	if err != nil {
+		return types.StringNull(), types.StringNull()
+	}
+	publicKey := signer.PublicKey()
+	return types.StringValue(string(ssh.MarshalAuthorizedKey(publicKey))), types.StringValue(ssh.FingerprintSHA256(publicKey))
+}
+
 func convertProjectKeyModelToAccessKeyRequest(key ProjectKeyModel) *models.AccessKeyRequest {
 	model := models.AccessKeyRequest{
 		ProjectID: key.ProjectID.ValueInt64(),
@@ -142,16 +269,26 @@ func (r *projectKeyResource) getProjectKeyModelFromClient(projectId types.Int64,
 
 func (r *projectKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectKeyModel
+	var plan ProjectKeyResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	err := generatePlannedSSHKey(&plan.ProjectKeyModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating SemaphoreUI Project Key",
+			"Could not generate SSH key pair, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	response, err := r.client.Project.PostProjectProjectIDKeys(&project.PostProjectProjectIDKeysParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		AccessKey: convertProjectKeyModelToAccessKeyRequest(plan),
+		AccessKey: convertProjectKeyModelToAccessKeyRequest(plan.ProjectKeyModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -160,7 +297,7 @@ func (r *projectKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		)
 		return
 	}
-	plan = convertAccessKeyResponseToProjectKeyModel(response.Payload, &plan)
+	plan.ProjectKeyModel = convertAccessKeyResponseToProjectKeyModel(response.Payload, &plan.ProjectKeyModel)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, &plan)
@@ -173,14 +310,14 @@ func (r *projectKeyResource) Create(ctx context.Context, req resource.CreateRequ
 // Read refreshes the Terraform state with the latest data.
 func (r *projectKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectKeyModel
+	var state ProjectKeyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &state)
+	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &state.ProjectKeyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Semaphore Project Keys",
@@ -189,8 +326,10 @@ func (r *projectKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	state.ProjectKeyModel = *model
+
 	// Set refreshed state
-	diags = resp.State.Set(ctx, &model)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -200,15 +339,25 @@ func (r *projectKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan and state
-	var plan, state ProjectKeyModel
+	var plan, state ProjectKeyResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	err := generatePlannedSSHKey(&plan.ProjectKeyModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating SemaphoreUI Project Key",
+			"Could not generate SSH key pair, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Create an access key based on the plan
-	key := convertProjectKeyModelToAccessKeyRequest(plan)
+	key := convertProjectKeyModelToAccessKeyRequest(plan.ProjectKeyModel)
 	// Check if type of key has changed
 	if !plan.Type().Equal(state.Type()) {
 		// If key type has changed, we must update the secrets
@@ -261,7 +410,7 @@ func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update existing resource
-	_, err := r.client.Project.PutProjectProjectIDKeysKeyID(&project.PutProjectProjectIDKeysKeyIDParams{
+	_, err = r.client.Project.PutProjectProjectIDKeysKeyID(&project.PutProjectProjectIDKeysKeyIDParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
 		KeyID:     plan.ID.ValueInt64(),
 		AccessKey: key,
@@ -275,7 +424,7 @@ func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Fetch updated values as PutProjectProjectIDKeysKeyID does not return updated projectKey
-	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &plan)
+	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &plan.ProjectKeyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Semaphore Project Keys",
@@ -284,8 +433,10 @@ func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	plan.ProjectKeyModel = *model
+
 	// Update resource state with updated projectKey
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -293,7 +444,7 @@ func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 
 func (r *projectKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectKeyModel
+	var state ProjectKeyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -342,7 +493,7 @@ func (r *projectKeyResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectKeyResourceModel{ProjectKeyModel: *model})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
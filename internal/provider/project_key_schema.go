@@ -2,15 +2,20 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 )
 
 type (
@@ -23,15 +28,28 @@ type (
 		None          *ProjectKeyNone          `tfsdk:"none"`
 	}
 
+	ProjectKeyResourceModel struct {
+		ProjectKeyModel
+		ProjectName types.String `tfsdk:"project_name"`
+	}
+
 	ProjectKeyLoginPassword struct {
 		Login    types.String `tfsdk:"login"`
 		Password types.String `tfsdk:"password"`
 	}
 
 	ProjectKeySSH struct {
-		Login      types.String `tfsdk:"login"`
-		Passphrase types.String `tfsdk:"passphrase"`
-		PrivateKey types.String `tfsdk:"private_key"`
+		Login            types.String                `tfsdk:"login"`
+		Passphrase       types.String                `tfsdk:"passphrase"`
+		PrivateKey       types.String                `tfsdk:"private_key"`
+		Generate         *ProjectKeySSHGenerateModel `tfsdk:"generate"`
+		PublicKeyOpenSSH types.String                `tfsdk:"public_key_openssh"`
+		Fingerprint      types.String                `tfsdk:"fingerprint"`
+	}
+
+	ProjectKeySSHGenerateModel struct {
+		Algorithm types.String `tfsdk:"algorithm"`
+		RSABits   types.Int64  `tfsdk:"rsa_bits"`
 	}
 
 	ProjectKeyNone struct{}
@@ -41,6 +59,9 @@ const (
 	ProjectKeyTypeLoginPassword string = "login_password"
 	ProjectKeyTypeSSH           string = "ssh"
 	ProjectKeyTypeNone          string = "none"
+
+	ProjectKeySSHAlgorithmED25519 string = "ed25519"
+	ProjectKeySSHAlgorithmRSA     string = "rsa"
 )
 
 func (model *ProjectKeyModel) Type() types.String {
@@ -88,10 +109,26 @@ func ProjectKeySchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the key belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the key belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"name": superschema.StringAttribute{
@@ -188,12 +225,99 @@ func ProjectKeySchema() superschema.Schema {
 							Sensitive:           true,
 						},
 						Resource: &schemaR.StringAttribute{
-							Optional: true,
+							MarkdownDescription: "Computed when `generate` is used.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								semaphorevalidator.SSHPrivateKey(path.MatchRelative().AtParent().AtName("passphrase")),
+							},
 						},
 						DataSource: &schemaD.StringAttribute{
 							Computed: true,
 						},
 					},
+					"generate": superschema.SingleNestedAttribute{
+						Common: &schemaR.SingleNestedAttribute{
+							MarkdownDescription: "Generate the SSH key pair in the provider instead of supplying `private_key`.",
+						},
+						Resource: &schemaR.SingleNestedAttribute{
+							MarkdownDescription: "The private key is uploaded to SemaphoreUI and stored in the Terraform state. Changing any of the generate options or the `passphrase` generates a new key pair.",
+							Optional:            true,
+							Validators: []validator.Object{
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key")),
+							},
+						},
+						DataSource: &schemaD.SingleNestedAttribute{
+							Computed: true,
+						},
+						Attributes: map[string]superschema.Attribute{
+							"algorithm": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The key algorithm.",
+								},
+								Resource: &schemaR.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString(ProjectKeySSHAlgorithmED25519),
+									Validators: []validator.String{
+										stringvalidator.OneOf(ProjectKeySSHAlgorithmED25519, ProjectKeySSHAlgorithmRSA),
+									},
+								},
+								DataSource: &schemaD.StringAttribute{
+									Computed: true,
+								},
+							},
+							"rsa_bits": superschema.Int64Attribute{
+								Common: &schemaR.Int64Attribute{
+									MarkdownDescription: "The size of the RSA key in bits. Only used with the `rsa` algorithm.",
+								},
+								Resource: &schemaR.Int64Attribute{
+									Optional: true,
+									Computed: true,
+									Default:  int64default.StaticInt64(4096),
+									Validators: []validator.Int64{
+										int64validator.Between(2048, 8192),
+									},
+								},
+								DataSource: &schemaD.Int64Attribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"public_key_openssh": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The public key in OpenSSH `authorized_keys` format.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Computed from the generated or supplied private key.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The API never returns key material, so this is always null.",
+						},
+					},
+					"fingerprint": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The SHA256 fingerprint of the public key.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Computed from the generated or supplied private key.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The API never returns key material, so this is always null.",
+						},
+					},
 				},
 			},
 			ProjectKeyTypeNone: superschema.SingleNestedAttribute{
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/projects"
+)
+
+// projectObject is the ID and name of a project object.
+type projectObject struct {
+	id   int64
+	name string
+}
+
+// projectObjectKind is a kind of project object that resources reference by ID or by name.
+type projectObjectKind struct {
+	title  string
+	plural string
+	// global is set for the projects themselves, which are not listed in a project.
+	global bool
+	list   func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error)
+}
+
+var (
+	projectKind = projectObjectKind{title: "Project", plural: "Projects", global: true, list: func(client *apiclient.SemaphoreUI, _ int64) ([]projectObject, error) {
+		response, err := client.Projects.GetProjects(&projects.GetProjectsParams{}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectKeyKind = projectObjectKind{title: "Project Key", plural: "Project Keys", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectEnvironmentKind = projectObjectKind{title: "Project Environment", plural: "Project Environments", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDEnvironment(&project.GetProjectProjectIDEnvironmentParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectInventoryKind = projectObjectKind{title: "Project Inventory", plural: "Project Inventories", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDInventory(&project.GetProjectProjectIDInventoryParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectRepositoryKind = projectObjectKind{title: "Project Repository", plural: "Project Repositories", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDRepositories(&project.GetProjectProjectIDRepositoriesParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectTemplateKind = projectObjectKind{title: "Project Template", plural: "Project Templates", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDTemplates(&project.GetProjectProjectIDTemplatesParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Name})
+		}
+		return objects, nil
+	}}
+	projectViewKind = projectObjectKind{title: "Project View", plural: "Project Views", list: func(client *apiclient.SemaphoreUI, projectID int64) ([]projectObject, error) {
+		response, err := client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{ProjectID: projectID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]projectObject, 0, len(response.Payload))
+		for _, item := range response.Payload {
+			objects = append(objects, projectObject{id: item.ID, name: item.Title})
+		}
+		return objects, nil
+	}}
+)
+
+// projectReference is an ID attribute that references an object of the project of the resource.
+type projectReference struct {
+	path path.Path
+	kind projectObjectKind
+	id   types.Int64
+}
+
+// validateProjectReferences checks that the known IDs of the planned references belong to the project, by listing the
+// objects of the project once for every kind of object. The references that the state already has for the same project
+// are not checked again, so only the new and changed references are.
+func validateProjectReferences(client *apiclient.SemaphoreUI, projectID types.Int64, references []projectReference, stateProjectID types.Int64, stateReferences []projectReference) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || projectID.IsNull() || projectID.IsUnknown() {
+		return diags
+	}
+
+	ids := map[string]map[int64]bool{}
+	for _, reference := range references {
+		if reference.id.IsNull() || reference.id.IsUnknown() {
+			continue
+		}
+		if projectID.Equal(stateProjectID) && hasProjectReference(stateReferences, reference) {
+			continue
+		}
+
+		known, ok := ids[reference.kind.plural]
+		if !ok {
+			objects, err := reference.kind.list(client, projectID.ValueInt64())
+			if err != nil {
+				diags.AddError(
+					"Error Reading SemaphoreUI "+reference.kind.plural,
+					fmt.Sprintf("Could not read %s to check %s, unexpected error: %s", strings.ToLower(reference.kind.plural), reference.path, err.Error()),
+				)
+				return diags
+			}
+			known = make(map[int64]bool, len(objects))
+			for _, object := range objects {
+				known[object.id] = true
+			}
+			ids[reference.kind.plural] = known
+		}
+		if !known[reference.id.ValueInt64()] {
+			diags.AddAttributeError(
+				reference.path,
+				"Invalid SemaphoreUI "+reference.kind.title,
+				fmt.Sprintf("The %s %d does not belong to the project %d. Use the ID of a %s of the same project.",
+					strings.ToLower(reference.kind.title), reference.id.ValueInt64(), projectID.ValueInt64(), strings.ToLower(reference.kind.title)),
+			)
+		}
+	}
+	return diags
+}
+
+func hasProjectReference(references []projectReference, reference projectReference) bool {
+	for _, other := range references {
+		if other.path.Equal(reference.path) && other.id.Equal(reference.id) {
+			return true
+		}
+	}
+	return false
+}
+
+// projectNameReference is a name attribute that references an object by name, as an alternative to the ID attribute
+// of the object.
+type projectNameReference struct {
+	idPath   path.Path
+	namePath path.Path
+	kind     projectObjectKind
+	// requiresReplace is set when a change of the ID attribute replaces the resource.
+	requiresReplace bool
+}
+
+// projectNameReferenceOfProject references the project of a resource by the project_name attribute.
+var projectNameReferenceOfProject = projectNameReference{
+	idPath:          path.Root("project_id"),
+	namePath:        path.Root("project_name"),
+	kind:            projectKind,
+	requiresReplace: true,
+}
+
+// resolveProjectNameReferences plans the ID attributes of the name attributes set in the configuration, by finding the
+// objects by name in the lists of the project of the resource, so the ID attributes are known at plan time. The ID is
+// unknown when the name or the project is unknown, or when there is no object with the name yet, such as an object
+// created in the same apply, and resolvePlannedProjectNameReferences resolves it when the resource is created or
+// updated. The project reference, when there is one, must come first so the other references are found in the
+// resolved project.
+func resolveProjectNameReferences(ctx context.Context, client *apiclient.SemaphoreUI, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, references ...projectNameReference) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, reference := range references {
+		if parentPath := reference.idPath.ParentPath(); len(parentPath.Steps()) > 0 {
+			var parent types.Object
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, parentPath, &parent)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if parent.IsNull() || parent.IsUnknown() {
+				continue
+			}
+		}
+
+		var name types.String
+		var configID types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, reference.namePath, &name)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, reference.idPath, &configID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if name.IsNull() {
+			if configID.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, reference.idPath, types.Int64Null())...)
+			}
+			continue
+		}
+
+		id := types.Int64Unknown()
+		projectID := types.Int64Value(0)
+		if !reference.kind.global {
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		if client != nil && !name.IsUnknown() && !projectID.IsUnknown() {
+			found, ok, diags := findProjectObjectByName(client, reference, projectID.ValueInt64(), name.ValueString())
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if ok {
+				id = types.Int64Value(found)
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, reference.idPath, id)...)
+
+		if reference.requiresReplace && !req.State.Raw.IsNull() {
+			var stateID types.Int64
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, reference.idPath, &stateID)...)
+			if !id.Equal(stateID) {
+				resp.RequiresReplace.Append(reference.idPath)
+			}
+		}
+	}
+}
+
+// resolvePlannedProjectNameReferences sets the ID attributes that are still unknown in the plan of a resource being
+// created or updated, from the name attributes, once the objects created in the same apply exist. An object that is
+// still not found is an error.
+func resolvePlannedProjectNameReferences(ctx context.Context, client *apiclient.SemaphoreUI, plan *tfsdk.Plan, references ...projectNameReference) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, reference := range references {
+		if parentPath := reference.idPath.ParentPath(); len(parentPath.Steps()) > 0 {
+			var parent types.Object
+			diags.Append(plan.GetAttribute(ctx, parentPath, &parent)...)
+			if diags.HasError() {
+				return diags
+			}
+			if parent.IsNull() || parent.IsUnknown() {
+				continue
+			}
+		}
+
+		var name types.String
+		var id types.Int64
+		diags.Append(plan.GetAttribute(ctx, reference.namePath, &name)...)
+		diags.Append(plan.GetAttribute(ctx, reference.idPath, &id)...)
+		if diags.HasError() {
+			return diags
+		}
+		if !id.IsUnknown() || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		projectID := types.Int64Value(0)
+		if !reference.kind.global {
+			diags.Append(plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+			if diags.HasError() || projectID.IsUnknown() {
+				return diags
+			}
+		}
+		found, ok, findDiags := findProjectObjectByName(client, reference, projectID.ValueInt64(), name.ValueString())
+		diags.Append(findDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if !ok {
+			diags.AddAttributeError(
+				reference.namePath,
+				"SemaphoreUI "+reference.kind.title+" Not Found",
+				fmt.Sprintf("There is no %s named %q%s.", strings.ToLower(reference.kind.title), name.ValueString(), projectObjectScope(reference, projectID.ValueInt64())),
+			)
+			return diags
+		}
+		diags.Append(plan.SetAttribute(ctx, reference.idPath, types.Int64Value(found))...)
+	}
+	return diags
+}
+
+// findProjectObjectByName returns the ID of the object with the name, and whether there is one. A name of several
+// objects is an error.
+func findProjectObjectByName(client *apiclient.SemaphoreUI, reference projectNameReference, projectID int64, name string) (int64, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objects, err := reference.kind.list(client, projectID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading SemaphoreUI "+reference.kind.plural,
+			fmt.Sprintf("Could not read %s to resolve %s, unexpected error: %s", strings.ToLower(reference.kind.plural), reference.namePath, err.Error()),
+		)
+		return 0, false, diags
+	}
+
+	var ids []int64
+	for _, object := range objects {
+		if object.name == name {
+			ids = append(ids, object.id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return 0, false, diags
+	case 1:
+		return ids[0], true, diags
+	default:
+		diags.AddAttributeError(
+			reference.namePath,
+			"Ambiguous SemaphoreUI "+reference.kind.title+" Name",
+			fmt.Sprintf("There are %d %s named %q%s. Use %s instead.", len(ids), strings.ToLower(reference.kind.plural), name, projectObjectScope(reference, projectID), reference.idPath),
+		)
+		return 0, false, diags
+	}
+}
+
+func projectObjectScope(reference projectNameReference, projectID int64) string {
+	if reference.kind.global {
+		return ""
+	}
+	return fmt.Sprintf(" in the project %d", projectID)
+}
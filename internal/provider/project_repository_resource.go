@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
@@ -14,6 +15,7 @@ var (
 	_ resource.Resource                = &projectRepositoryResource{}
 	_ resource.ResourceWithConfigure   = &projectRepositoryResource{}
 	_ resource.ResourceWithImportState = &projectRepositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &projectRepositoryResource{}
 )
 
 func NewProjectRepositoryResource() resource.Resource {
@@ -29,16 +31,16 @@ func (r *projectRepositoryResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectRepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,7 +78,8 @@ func convertRepositoryResponseToProjectRepositoryModel(request *models.Repositor
 
 func (r *projectRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectRepositoryModel
+	var plan ProjectRepositoryResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectRepositoryNameReferences...)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -84,7 +87,7 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 
 	response, err := r.client.Project.PostProjectProjectIDRepositories(&project.PostProjectProjectIDRepositoriesParams{
 		ProjectID:  plan.ProjectID.ValueInt64(),
-		Repository: convertProjectRepositoryModelToRepositoryRequest(plan),
+		Repository: convertProjectRepositoryModelToRepositoryRequest(plan.ProjectRepositoryModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -93,10 +96,10 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 		)
 		return
 	}
-	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
+	plan.ProjectRepositoryModel = convertRepositoryResponseToProjectRepositoryModel(response.Payload)
 
 	// Set state to fully populated data
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -105,7 +108,7 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 // Read refreshes the Terraform state with the latest data.
 func (r *projectRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectRepositoryModel
+	var state ProjectRepositoryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -122,10 +125,10 @@ func (r *projectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 		)
 		return
 	}
-	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
+	state.ProjectRepositoryModel = convertRepositoryResponseToProjectRepositoryModel(response.Payload)
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -134,7 +137,8 @@ func (r *projectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectRepositoryModel
+	var plan ProjectRepositoryResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectRepositoryNameReferences...)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -143,7 +147,7 @@ func (r *projectRepositoryResource) Update(ctx context.Context, req resource.Upd
 	_, err := r.client.Project.PutProjectProjectIDRepositoriesRepositoryID(&project.PutProjectProjectIDRepositoriesRepositoryIDParams{
 		ProjectID:    plan.ProjectID.ValueInt64(),
 		RepositoryID: plan.ID.ValueInt64(),
-		Repository:   convertProjectRepositoryModelToRepositoryRequest(plan),
+		Repository:   convertProjectRepositoryModelToRepositoryRequest(plan.ProjectRepositoryModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -164,17 +168,56 @@ func (r *projectRepositoryResource) Update(ctx context.Context, req resource.Upd
 		)
 		return
 	}
-	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
+	plan.ProjectRepositoryModel = convertRepositoryResponseToProjectRepositoryModel(response.Payload)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// projectRepositoryNameReferences are the name attributes of the repository.
+var projectRepositoryNameReferences = []projectNameReference{
+	projectNameReferenceOfProject,
+	{idPath: path.Root("ssh_key_id"), namePath: path.Root("ssh_key_name"), kind: projectKeyKind},
+}
+
+// ModifyPlan resolves the project and SSH key names of the repository, and checks that the SSH key of the repository
+// belongs to the project of the repository.
+func (r *projectRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectRepositoryNameReferences...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectRepositoryResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	references := []projectReference{{path: path.Root("ssh_key_id"), kind: projectKeyKind, id: plan.SSHKeyID}}
+	var stateReferences []projectReference
+	stateProjectID := types.Int64Null()
+	if !req.State.Raw.IsNull() {
+		var state ProjectRepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateReferences = []projectReference{{path: path.Root("ssh_key_id"), kind: projectKeyKind, id: state.SSHKeyID}}
+		stateProjectID = state.ProjectID
+	}
+
+	resp.Diagnostics.Append(validateProjectReferences(r.client, plan.ProjectID, references, stateProjectID, stateReferences)...)
+}
+
 func (r *projectRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectRepositoryModel
+	var state ProjectRepositoryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,7 +257,7 @@ func (r *projectRepositoryResource) ImportState(ctx context.Context, req resourc
 		)
 		return
 	}
-	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
+	model := ProjectRepositoryResourceModel{ProjectRepositoryModel: convertRepositoryResponseToProjectRepositoryModel(response.Payload)}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"testing"
@@ -132,3 +133,83 @@ func TestAcc_ProjectRepositoryResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func testAccProjectRepositoryOtherProjectConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project" "other" {
+  name = "other-%[2]s"
+}
+
+resource "semaphoreui_project_key" "other" {
+  project_id = semaphoreui_project.other.id
+  name       = "other-%[2]s"
+  none = {}
+}
+`, testAccProjectRepositoryEmptyConfig(nameSuffix), nameSuffix)
+}
+
+func TestAcc_ProjectRepositoryResource_otherProjectKey(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRepositoryOtherProjectConfig(nameSuffix),
+			},
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test %[2]s"
+  url        = "https://github.com/semaphoreui/semaphore.git"
+  branch     = "develop"
+  ssh_key_id = semaphoreui_project_key.other.id
+}`, testAccProjectRepositoryOtherProjectConfig(nameSuffix), nameSuffix),
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Project Key"),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectRepositoryResource_names(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_repository" "test" {
+  project_name = semaphoreui_project.test.name
+  name         = "Test %[2]s"
+  url          = "https://github.com/semaphoreui/semaphore.git"
+  branch       = "develop"
+  ssh_key_name = semaphoreui_project_key.test.name
+}`, testAccProjectRepositoryEmptyConfig(nameSuffix), nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectRepositoryExists("semaphoreui_project_repository.test"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_repository.test", "project_id", "semaphoreui_project.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_repository.test", "ssh_key_id", "semaphoreui_project_key.test", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_repository.test", "project_name", fmt.Sprintf("test-%s", nameSuffix)),
+					resource.TestCheckResourceAttr("semaphoreui_project_repository.test", "ssh_key_name", fmt.Sprintf("test-%s", nameSuffix)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_repository" "test" {
+  project_name = semaphoreui_project.test.name
+  name         = "Test %[2]s"
+  url          = "https://github.com/semaphoreui/semaphore.git"
+  branch       = "develop"
+  ssh_key_name = "missing-%[2]s"
+}`, testAccProjectRepositoryEmptyConfig(nameSuffix), nameSuffix),
+				ExpectError: regexp.MustCompile("SemaphoreUI Project Key Not Found"),
+			},
+		},
+	})
+}
@@ -22,6 +22,12 @@ type (
 		Branch    types.String `tfsdk:"branch"`
 		SSHKeyID  types.Int64  `tfsdk:"ssh_key_id"`
 	}
+
+	ProjectRepositoryResourceModel struct {
+		ProjectRepositoryModel
+		ProjectName types.String `tfsdk:"project_name"`
+		SSHKeyName  types.String `tfsdk:"ssh_key_name"`
+	}
 )
 
 func ProjectRepositorySchema() superschema.Schema {
@@ -58,10 +64,26 @@ func ProjectRepositorySchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the repository belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the repository belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"name": superschema.StringAttribute{
@@ -111,13 +133,26 @@ func ProjectRepositorySchema() superschema.Schema {
 					MarkdownDescription: "The Project Key ID to use for accessing the Git repository.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					MarkdownDescription: "This attribute is required for all repositories in SemaphoreUI. You should set it to the ID of a Key of type \"`none`\" if the repository doesn't require credentials.",
-					Required:            true,
+					MarkdownDescription: "This attribute, or `ssh_key_name`, is required for all repositories in SemaphoreUI. You should set it to the ID of a Key of type \"`none`\" if the repository doesn't require credentials.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("ssh_key_id"),
+							path.MatchRoot("ssh_key_name"),
+						),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"ssh_key_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project key to use for accessing the Git repository, instead of `ssh_key_id`.",
+					Optional:            true,
+				},
+			},
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
@@ -26,15 +27,15 @@ func (d *projectScheduleDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.Client
 }
 
 // Metadata returns the data source type name.
@@ -65,7 +66,9 @@ func (d *projectScheduleDataSource) Read(ctx context.Context, req datasource.Rea
 		)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, &ProjectScheduleModel{})
+	model.Timezone = config.Timezone
+	resp.Diagnostics.Append(setScheduleNextRuns(&model, time.Now())...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
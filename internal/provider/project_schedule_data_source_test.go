@@ -39,11 +39,12 @@ resource "semaphoreui_project_inventory" "test" {
 resource "semaphoreui_project_environment" "test" {
   project_id = semaphoreui_project.test.id
   name       = "Environment"
-  secrets = [{
-    name  = "SECRET_ONE"
-    type  = "var"
-    value = "VALUE_ONE"
-  }]
+  secrets = {
+    SECRET_ONE = {
+      type  = "var"
+      value = "VALUE_ONE"
+    }
+  }
 }
 
 # Task Template
@@ -82,6 +83,7 @@ func TestAcc_ProjectScheduleDataSource_basicID(t *testing.T) {
 					resource.TestCheckResourceAttr("data.semaphoreui_project_schedule.test", "name", "Test Schedule"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_schedule.test", "cron_format", "0 0 * * *"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_schedule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_schedule.test", "next_runs.#", "5"),
 					resource.TestCheckResourceAttrSet("data.semaphoreui_project_schedule.test", "id"),
 					resource.TestCheckResourceAttrSet("data.semaphoreui_project_schedule.test", "project_id"),
 				),
@@ -2,6 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/adhocore/gronx"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
@@ -14,14 +23,19 @@ var (
 	_ resource.Resource                = &projectScheduleResource{}
 	_ resource.ResourceWithConfigure   = &projectScheduleResource{}
 	_ resource.ResourceWithImportState = &projectScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &projectScheduleResource{}
 )
 
+// scheduleNextRunsCount is the number of next runs computed for schedules.
+const scheduleNextRunsCount = 5
+
 func NewProjectScheduleResource() resource.Resource {
 	return &projectScheduleResource{}
 }
 
 type projectScheduleResource struct {
-	client *apiclient.SemaphoreUI
+	client              *apiclient.SemaphoreUI
+	scheduleMinInterval time.Duration
 }
 
 func (r *projectScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -29,16 +43,17 @@ func (r *projectScheduleResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
+	r.scheduleMinInterval = data.ScheduleMinInterval
 }
 
 func (r *projectScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,7 +64,10 @@ func (r *projectScheduleResource) Schema(ctx context.Context, _ resource.SchemaR
 	resp.Schema = ProjectScheduleSchema().GetResource(ctx)
 }
 
-func convertProjectScheduleModelToRepositorySchedule(schedule ProjectScheduleModel) *models.ScheduleRequest {
+// convertProjectScheduleModelToRepositorySchedule returns the schedule to send to the API, and the diagnostics of the
+// conversion of its run time and task parameters.
+func convertProjectScheduleModelToRepositorySchedule(ctx context.Context, schedule ProjectScheduleModel) (*models.ScheduleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	model := models.ScheduleRequest{
 		ProjectID:  schedule.ProjectID.ValueInt64(),
 		TemplateID: schedule.TemplateID.ValueInt64(),
@@ -60,31 +78,245 @@ func convertProjectScheduleModelToRepositorySchedule(schedule ProjectScheduleMod
 	if !schedule.ID.IsNull() && !schedule.ID.IsUnknown() {
 		model.ID = schedule.ID.ValueInt64()
 	}
-	return &model
+	if !schedule.RunAt.IsNull() && !schedule.RunAt.IsUnknown() {
+		runAt, err := time.Parse(time.RFC3339, schedule.RunAt.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("run_at"),
+				"Invalid Schedule Run Time",
+				"Could not parse the schedule run time: "+err.Error(),
+			)
+			return nil, diags
+		}
+		model.Type = models.ScheduleRequestTypeRunAt
+		dateTime := strfmt.DateTime(runAt)
+		model.RunAt = &dateTime
+	}
+	if schedule.TaskParams != nil {
+		model.TaskParams = &models.TaskPrams{
+			GitBranch: schedule.TaskParams.GitBranch.ValueString(),
+		}
+		if !schedule.TaskParams.Arguments.IsNull() && !schedule.TaskParams.Arguments.IsUnknown() {
+			var arguments []string
+			diags.Append(schedule.TaskParams.Arguments.ElementsAs(ctx, &arguments, false)...)
+			args, err := json.Marshal(arguments)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("task_params").AtName("arguments"),
+					"Invalid Schedule Arguments",
+					"Could not encode the schedule arguments: "+err.Error(),
+				)
+			}
+			model.TaskParams.Arguments = string(args)
+		}
+		if !schedule.TaskParams.SurveyValues.IsNull() && !schedule.TaskParams.SurveyValues.IsUnknown() {
+			var values map[string]string
+			diags.Append(schedule.TaskParams.SurveyValues.ElementsAs(ctx, &values, false)...)
+			environment, err := json.Marshal(values)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("task_params").AtName("survey_values"),
+					"Invalid Schedule Survey Values",
+					"Could not encode the schedule survey values: "+err.Error(),
+				)
+			}
+			model.TaskParams.Environment = string(environment)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &model, diags
 }
 
-func convertScheduleResponseToProjectScheduleModel(request *models.Schedule) ProjectScheduleModel {
-	return ProjectScheduleModel{
+func convertScheduleResponseToProjectScheduleModel(ctx context.Context, request *models.Schedule, prev *ProjectScheduleModel) ProjectScheduleModel {
+	model := ProjectScheduleModel{
 		ID:         types.Int64Value(request.ID),
 		ProjectID:  types.Int64Value(request.ProjectID),
 		TemplateID: types.Int64Value(request.TemplateID),
 		Name:       types.StringValue(request.Name),
-		CronFormat: types.StringValue(request.CronFormat),
+		CronFormat: types.StringNull(),
+		RunAt:      types.StringNull(),
 		Enabled:    types.BoolValue(request.Active),
+		Timezone:   types.StringNull(),
+		NextRuns:   types.ListNull(types.StringType),
+	}
+
+	if request.Type == models.ScheduleTypeRunAt && request.RunAt != nil {
+		runAt := time.Time(*request.RunAt)
+		// Keep the configured representation of the same instant, SemaphoreUI returns it in UTC
+		if prevRunAt, err := time.Parse(time.RFC3339, prev.RunAt.ValueString()); err == nil && prevRunAt.Equal(runAt) {
+			model.RunAt = prev.RunAt
+		} else {
+			model.RunAt = types.StringValue(runAt.UTC().Format(time.RFC3339))
+		}
+		// SemaphoreUI disables run_at schedules once they have run, which is not a change of the configuration
+		if !request.Active && !runAt.After(time.Now()) && !prev.Enabled.IsNull() {
+			model.Enabled = prev.Enabled
+		}
+	} else {
+		model.CronFormat = types.StringValue(request.CronFormat)
+	}
+
+	model.TaskParams = convertScheduleTaskParamsToProjectScheduleTaskParamsModel(ctx, request.TaskParams, prev.TaskParams)
+	return model
+}
+
+func convertScheduleTaskParamsToProjectScheduleTaskParamsModel(ctx context.Context, params *models.TaskPrams, prev *ProjectScheduleTaskParamsModel) *ProjectScheduleTaskParamsModel {
+	if params == nil {
+		params = &models.TaskPrams{}
+	}
+	var arguments []string
+	if params.Arguments != "" {
+		_ = json.Unmarshal([]byte(params.Arguments), &arguments)
+	}
+	var surveyValues map[string]string
+	if params.Environment != "" {
+		_ = json.Unmarshal([]byte(params.Environment), &surveyValues)
+	}
+	if prev == nil && params.GitBranch == "" && len(arguments) == 0 && len(surveyValues) == 0 {
+		return nil
+	}
+
+	model := ProjectScheduleTaskParamsModel{
+		GitBranch:    types.StringNull(),
+		Arguments:    types.ListNull(types.StringType),
+		SurveyValues: types.MapNull(types.StringType),
+	}
+	if params.GitBranch != "" {
+		model.GitBranch = types.StringValue(params.GitBranch)
+	} else if prev != nil {
+		model.GitBranch = prev.GitBranch
+	}
+	if len(arguments) != 0 {
+		model.Arguments, _ = types.ListValueFrom(ctx, types.StringType, arguments)
+	} else if prev != nil {
+		model.Arguments = prev.Arguments
+	}
+	if len(surveyValues) != 0 {
+		model.SurveyValues, _ = types.MapValueFrom(ctx, types.StringType, surveyValues)
+	} else if prev != nil {
+		model.SurveyValues = prev.SurveyValues
+	}
+	return &model
+}
+
+// getTemplateSchedules returns all the schedules of the template.
+func getTemplateSchedules(client *apiclient.SemaphoreUI, projectID int64, templateID int64) ([]*models.Schedule, error) {
+	response, err := client.Schedule.GetProjectProjectIDTemplatesTemplateIDSchedules(&schedule.GetProjectProjectIDTemplatesTemplateIDSchedulesParams{
+		ProjectID:  projectID,
+		TemplateID: templateID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read template schedules: %s", err.Error())
+	}
+	return response.Payload, nil
+}
+
+// scheduleNextRuns returns the next runs of the cron format after the given time, in the time zone, UTC when it is
+// empty.
+func scheduleNextRuns(cronFormat string, timezone string, after time.Time) ([]time.Time, error) {
+	location := time.UTC
+	if timezone != "" {
+		var err error
+		if location, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	runs := make([]time.Time, 0, scheduleNextRunsCount)
+	next := after.In(location)
+	for len(runs) < scheduleNextRunsCount {
+		var err error
+		if next, err = gronx.NextTickAfter(cronFormat, next, false); err != nil {
+			return nil, err
+		}
+		runs = append(runs, next)
+	}
+	return runs, nil
+}
+
+// scheduleRunAt returns the run_at time in the time zone, UTC when it is empty, or none when it is not after the given
+// time.
+func scheduleRunAt(runAt string, timezone string, after time.Time) ([]time.Time, error) {
+	location := time.UTC
+	if timezone != "" {
+		var err error
+		if location, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	run, err := time.Parse(time.RFC3339, runAt)
+	if err != nil {
+		return nil, err
+	}
+	if !run.After(after) {
+		return nil, nil
+	}
+	return []time.Time{run.In(location)}, nil
+}
+
+// setScheduleNextRuns sets the next runs of the schedule after the given time, none when the schedule is disabled. The
+// next run of a run_at schedule is its run_at time, until it has passed.
+func setScheduleNextRuns(model *ProjectScheduleModel, after time.Time) diag.Diagnostics {
+	var runs []time.Time
+	if model.Enabled.ValueBool() {
+		var err error
+		if !model.RunAt.IsNull() {
+			runs, err = scheduleRunAt(model.RunAt.ValueString(), model.Timezone.ValueString(), after)
+		} else {
+			runs, err = scheduleNextRuns(model.CronFormat.ValueString(), model.Timezone.ValueString(), after)
+		}
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddAttributeError(
+				path.Root("next_runs"),
+				"Invalid SemaphoreUI Project Schedule",
+				"Could not compute the next runs of the schedule: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	values := make([]attr.Value, 0, len(runs))
+	for _, run := range runs {
+		values = append(values, types.StringValue(run.Format(time.RFC3339)))
 	}
+	var diags diag.Diagnostics
+	model.NextRuns, diags = types.ListValue(types.StringType, values)
+	return diags
+}
+
+// scheduleShortestInterval returns the shortest interval between the runs.
+func scheduleShortestInterval(runs []time.Time) time.Duration {
+	var shortest time.Duration
+	for i := 1; i < len(runs); i++ {
+		if interval := runs[i].Sub(runs[i-1]); i == 1 || interval < shortest {
+			shortest = interval
+		}
+	}
+	return shortest
 }
 
 func (r *projectScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectScheduleModel
+	var plan ProjectScheduleResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	payload, diags := convertProjectScheduleModelToRepositorySchedule(ctx, plan.ProjectScheduleModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, err := r.client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		Schedule:  convertProjectScheduleModelToRepositorySchedule(plan),
+		Schedule:  payload,
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -93,7 +325,13 @@ func (r *projectScheduleResource) Create(ctx context.Context, req resource.Creat
 		)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := plan
+	model.ProjectScheduleModel = convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, &plan.ProjectScheduleModel)
+	model.Timezone = plan.Timezone
+	model.NextRuns = plan.NextRuns
+	if model.NextRuns.IsUnknown() {
+		resp.Diagnostics.Append(setScheduleNextRuns(&model.ProjectScheduleModel, time.Now())...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -104,7 +342,7 @@ func (r *projectScheduleResource) Create(ctx context.Context, req resource.Creat
 // Read refreshes the Terraform state with the latest data.
 func (r *projectScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectScheduleModel
+	var state ProjectScheduleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -121,7 +359,16 @@ func (r *projectScheduleResource) Read(ctx context.Context, req resource.ReadReq
 		)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	// SemaphoreUI does not store the time zone, it is kept from the state
+	model := state
+	model.ProjectScheduleModel = convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, &state.ProjectScheduleModel)
+	model.Timezone = state.Timezone
+	// The next runs are only computed again when the schedule changed outside of Terraform, so they don't drift as
+	// time passes
+	model.NextRuns = state.NextRuns
+	if state.NextRuns.IsNull() || !model.CronFormat.Equal(state.CronFormat) || !model.RunAt.Equal(state.RunAt) || !model.Enabled.Equal(state.Enabled) {
+		resp.Diagnostics.Append(setScheduleNextRuns(&model.ProjectScheduleModel, time.Now())...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -132,16 +379,23 @@ func (r *projectScheduleResource) Read(ctx context.Context, req resource.ReadReq
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectScheduleModel
+	var plan ProjectScheduleResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	payload, diags := convertProjectScheduleModelToRepositorySchedule(ctx, plan.ProjectScheduleModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.Schedule.PutProjectProjectIDSchedulesScheduleID(&schedule.PutProjectProjectIDSchedulesScheduleIDParams{
 		ProjectID:  plan.ProjectID.ValueInt64(),
 		ScheduleID: plan.ID.ValueInt64(),
-		Schedule:   convertProjectScheduleModelToRepositorySchedule(plan),
+		Schedule:   payload,
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -162,7 +416,13 @@ func (r *projectScheduleResource) Update(ctx context.Context, req resource.Updat
 		)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := plan
+	model.ProjectScheduleModel = convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, &plan.ProjectScheduleModel)
+	model.Timezone = plan.Timezone
+	model.NextRuns = plan.NextRuns
+	if model.NextRuns.IsUnknown() {
+		resp.Diagnostics.Append(setScheduleNextRuns(&model.ProjectScheduleModel, time.Now())...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
@@ -170,9 +430,77 @@ func (r *projectScheduleResource) Update(ctx context.Context, req resource.Updat
 	}
 }
 
+// ModifyPlan computes the next runs of the schedule when it is created, or when its cron format, run at time, enabled
+// state, or time zone changes. It warns when the schedule runs more often than the provider schedule_min_interval, or
+// when its run at time has already passed. The project name is resolved first.
+func (r *projectScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectScheduleResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state ProjectScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.CronFormat.Equal(state.CronFormat) && plan.RunAt.Equal(state.RunAt) && plan.Enabled.Equal(state.Enabled) && plan.Timezone.Equal(state.Timezone) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), state.NextRuns)...)
+			return
+		}
+	}
+	if plan.CronFormat.IsUnknown() || plan.RunAt.IsUnknown() || plan.Enabled.IsUnknown() || plan.Timezone.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(setScheduleNextRuns(&plan.ProjectScheduleModel, time.Now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), plan.NextRuns)...)
+
+	if !plan.RunAt.IsNull() {
+		if runAt, err := time.Parse(time.RFC3339, plan.RunAt.ValueString()); err == nil && !runAt.After(time.Now()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("run_at"),
+				"Past SemaphoreUI Project Schedule",
+				fmt.Sprintf("The schedule %q runs at %s, which has already passed. SemaphoreUI will not run it.",
+					plan.Name.ValueString(), plan.RunAt.ValueString()),
+			)
+		}
+		return
+	}
+
+	minInterval := r.scheduleMinInterval
+	if minInterval == 0 || !plan.Enabled.ValueBool() {
+		return
+	}
+	runs, err := scheduleNextRuns(plan.CronFormat.ValueString(), plan.Timezone.ValueString(), time.Now())
+	if err != nil {
+		return
+	}
+	if interval := scheduleShortestInterval(runs); interval < minInterval {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("cron_format"),
+			"Frequent SemaphoreUI Project Schedule",
+			fmt.Sprintf("The schedule %q runs every %s, more often than the provider schedule_min_interval of %s. "+
+				"Each run executes the template.", plan.Name.ValueString(), interval, minInterval),
+		)
+	}
+}
+
 func (r *projectScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectScheduleModel
+	var state ProjectScheduleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,7 +540,8 @@ func (r *projectScheduleResource) ImportState(ctx context.Context, req resource.
 		)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := ProjectScheduleResourceModel{ProjectScheduleModel: convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, &ProjectScheduleModel{})}
+	resp.Diagnostics.Append(setScheduleNextRuns(&model.ProjectScheduleModel, time.Now())...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
 	"testing"
@@ -132,6 +136,7 @@ func TestAcc_ProjectScheduleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "cron_format", "0 0 * * *"),
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.#", "0"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "project_id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "template_id"),
@@ -152,6 +157,7 @@ func TestAcc_ProjectScheduleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "cron_format", "0 0 * * *"),
 					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.#", "5"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "project_id"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_schedule.test", "template_id"),
@@ -167,3 +173,141 @@ func TestAcc_ProjectScheduleResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func testAccProjectScheduleTimezoneConfig(nameSuffix string, timezone string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_schedule" "test" {
+  project_id  = semaphoreui_project.test.id
+  name        = "Test %[2]s"
+  template_id = semaphoreui_project_template.test.id
+  cron_format = "30 6 * * 1-5"
+  enabled     = true
+  timezone    = "%[3]s"
+}
+`, testAccProjectScheduleDependencyConfig(nameSuffix), nameSuffix, timezone)
+}
+
+func TestAcc_ProjectScheduleResource_timezone(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectScheduleTimezoneConfig(nameSuffix, "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile("Invalid timezone"),
+			},
+			{
+				Config: testAccProjectScheduleTimezoneConfig(nameSuffix, "Europe/Paris"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.#", "5"),
+					resource.TestMatchResourceAttr("semaphoreui_project_schedule.test", "next_runs.0", regexp.MustCompile(`T06:30:00\+0[12]:00$`)),
+				),
+			},
+			{
+				Config: testAccProjectScheduleTimezoneConfig(nameSuffix, "UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.#", "5"),
+					resource.TestMatchResourceAttr("semaphoreui_project_schedule.test", "next_runs.0", regexp.MustCompile(`T06:30:00Z$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectScheduleRunAtConfig(nameSuffix string, runAt string, gitBranch string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_schedule" "test" {
+  project_id  = semaphoreui_project.test.id
+  name        = "Test %[2]s"
+  template_id = semaphoreui_project_template.test.id
+  run_at      = "%[3]s"
+  enabled     = true
+  timezone    = "Europe/Paris"
+  task_params = {
+    git_branch = "%[4]s"
+    arguments  = ["--verbose"]
+    survey_values = {
+      version = "1.2.3"
+    }
+  }
+}
+`, testAccProjectScheduleDependencyConfig(nameSuffix), nameSuffix, runAt, gitBranch)
+}
+
+func TestAcc_ProjectScheduleResource_runAt(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectScheduleRunAtConfig(nameSuffix, "tomorrow", "main"),
+				ExpectError: regexp.MustCompile("Invalid time"),
+			},
+			{
+				Config: testAccProjectScheduleRunAtConfig(nameSuffix, "2099-01-31T23:00:00+01:00", "main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_schedule.test", "cron_format"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "run_at", "2099-01-31T23:00:00+01:00"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.#", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.0", "2099-01-31T23:00:00+01:00"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.git_branch", "main"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.arguments.#", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.arguments.0", "--verbose"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.survey_values.version", "1.2.3"),
+				),
+			},
+			{
+				Config: testAccProjectScheduleRunAtConfig(nameSuffix, "2099-02-28T12:00:00Z", "develop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "run_at", "2099-02-28T12:00:00Z"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "next_runs.0", "2099-02-28T13:00:00+01:00"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.git_branch", "develop"),
+				),
+			},
+		},
+	})
+}
+
+func TestConvertProjectScheduleModelToRepositorySchedule(t *testing.T) {
+	ctx := context.Background()
+	schedule := ProjectScheduleModel{
+		ProjectID:  types.Int64Value(1),
+		TemplateID: types.Int64Value(2),
+		Name:       types.StringValue("Nightly"),
+		CronFormat: types.StringValue("0 0 * * *"),
+		RunAt:      types.StringNull(),
+		Enabled:    types.BoolValue(true),
+		TaskParams: &ProjectScheduleTaskParamsModel{
+			GitBranch:    types.StringNull(),
+			Arguments:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("-v")}),
+			SurveyValues: types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		},
+	}
+	request, diags := convertProjectScheduleModelToRepositorySchedule(ctx, schedule)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if request.TaskParams.Arguments != `["-v"]` || request.TaskParams.Environment != `{"env":"prod"}` {
+		t.Fatalf("unexpected task params: %+v", request.TaskParams)
+	}
+
+	// Values that can not be converted are reported rather than sent as empty values
+	schedule.TaskParams.Arguments = types.ListValueMust(types.StringType, []attr.Value{types.StringNull()})
+	if _, diags := convertProjectScheduleModelToRepositorySchedule(ctx, schedule); !diags.HasError() {
+		t.Fatal("expected diagnostics for a null argument")
+	}
+	schedule.TaskParams = nil
+	schedule.RunAt = types.StringValue("tomorrow")
+	if _, diags := convertProjectScheduleModelToRepositorySchedule(ctx, schedule); !diags.HasError() {
+		t.Fatal("expected diagnostics for an invalid run time")
+	}
+}
@@ -1,24 +1,44 @@
 package provider
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
-	"terraform-provider-semaphoreui/internal/stringvalidator"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 )
 
 type (
 	ProjectScheduleModel struct {
-		ID         types.Int64  `tfsdk:"id"`
-		ProjectID  types.Int64  `tfsdk:"project_id"`
-		TemplateID types.Int64  `tfsdk:"template_id"`
-		Name       types.String `tfsdk:"name"`
-		CronFormat types.String `tfsdk:"cron_format"`
-		Enabled    types.Bool   `tfsdk:"enabled"`
+		ID         types.Int64                     `tfsdk:"id"`
+		ProjectID  types.Int64                     `tfsdk:"project_id"`
+		TemplateID types.Int64                     `tfsdk:"template_id"`
+		Name       types.String                    `tfsdk:"name"`
+		CronFormat types.String                    `tfsdk:"cron_format"`
+		Enabled    types.Bool                      `tfsdk:"enabled"`
+		RunAt      types.String                    `tfsdk:"run_at"`
+		TaskParams *ProjectScheduleTaskParamsModel `tfsdk:"task_params"`
+		Timezone   types.String                    `tfsdk:"timezone"`
+		NextRuns   types.List                      `tfsdk:"next_runs"`
+	}
+
+	ProjectScheduleResourceModel struct {
+		ProjectScheduleModel
+		ProjectName types.String `tfsdk:"project_name"`
+	}
+
+	ProjectScheduleTaskParamsModel struct {
+		GitBranch    types.String `tfsdk:"git_branch"`
+		Arguments    types.List   `tfsdk:"arguments"`
+		SurveyValues types.Map    `tfsdk:"survey_values"`
 	}
 )
 
@@ -28,7 +48,7 @@ func ProjectScheduleSchema() superschema.Schema {
 			MarkdownDescription: "The project schedule",
 		},
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "resource allows you to schedule the execution of templates in a project.",
+			MarkdownDescription: "resource allows you to schedule the execution of templates in a project, either recurring with `cron_format`, or once with `run_at`.",
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: "data source allows you to read a project schedule",
@@ -49,10 +69,26 @@ func ProjectScheduleSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the schedule belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the schedule belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"template_id": superschema.Int64Attribute{
@@ -83,15 +119,85 @@ func ProjectScheduleSchema() superschema.Schema {
 					MarkdownDescription: "The cron format of the schedule.",
 				},
 				Resource: &schemaR.StringAttribute{
-					Required: true,
+					Optional: true,
 					Validators: []validator.String{
-						stringvalidator.CronFormat(),
+						stringvalidator.ExactlyOneOf(
+							path.MatchRoot("cron_format"),
+							path.MatchRoot("run_at"),
+						),
+						semaphorevalidator.CronFormat(),
 					},
 				},
 				DataSource: &schemaD.StringAttribute{
 					Computed: true,
 				},
 			},
+			"run_at": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The time the schedule runs once, in RFC 3339 format. SemaphoreUI disables the schedule once it has run, the provider then keeps `enabled` as configured instead of planning to enable it again.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(
+							path.MatchRoot("cron_format"),
+							path.MatchRoot("run_at"),
+						),
+						semaphorevalidator.RFC3339(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"task_params": superschema.SingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The parameters of the tasks run by the schedule, overriding the ones of the template.",
+				},
+				Resource: &schemaR.SingleNestedAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.SingleNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"git_branch": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The git branch of the template repository to run the task on.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"arguments": superschema.ListAttribute{
+						Common: &schemaR.ListAttribute{
+							MarkdownDescription: "Commandline arguments passed to the application, in addition to the template arguments.",
+							ElementType:         types.StringType,
+						},
+						Resource: &schemaR.ListAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.ListAttribute{
+							Computed: true,
+						},
+					},
+					"survey_values": superschema.MapAttribute{
+						Common: &schemaR.MapAttribute{
+							MarkdownDescription: "The values of the template survey variables, by variable name.",
+							ElementType:         types.StringType,
+						},
+						Resource: &schemaR.MapAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.MapAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
 			"enabled": superschema.BoolAttribute{
 				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "Whether the schedule is enabled.",
@@ -103,6 +209,28 @@ func ProjectScheduleSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"timezone": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The time zone SemaphoreUI evaluates the schedule in, used to compute `next_runs`. SemaphoreUI does not store the time zone of schedules, it evaluates all of them in the time zone of the server (the `SEMAPHORE_SCHEDULE_TIMEZONE` setting), so set it to that time zone. Defaults to `UTC`.",
+					Optional:            true,
+					Validators: []validator.String{
+						semaphorevalidator.Timezone(),
+					},
+				},
+			},
+			"next_runs": superschema.ListAttribute{
+				Common: &schemaR.ListAttribute{
+					MarkdownDescription: fmt.Sprintf("The next %d times the schedule runs, in RFC 3339 format in the schedule `timezone`. Only the `run_at` time for `run_at` schedules, until it has passed. Empty when the schedule is disabled.", scheduleNextRunsCount),
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+				Resource: &schemaR.ListAttribute{
+					MarkdownDescription: "They are computed when the schedule is created or imported, and on plan when `cron_format`, `run_at`, `enabled` or `timezone` change, so the plan shows when a change triggers the template. They are not refreshed as time passes, only when the schedule is changed outside of Terraform.",
+				},
+				DataSource: &schemaD.ListAttribute{
+					MarkdownDescription: "They are computed when the schedule is read.",
+				},
+			},
 		},
 	}
 }
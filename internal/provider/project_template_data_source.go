@@ -28,15 +28,15 @@ func (d *projectTemplateDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.Client
 }
 
 // Metadata returns the data source type name.
@@ -97,6 +97,22 @@ func (d *projectTemplateDataSource) Read(ctx context.Context, req datasource.Rea
 		model = convertTemplateResponseToProjectTemplateModel(ctx, template, &config)
 	}
 
+	model.Schedules = []ProjectTemplateScheduleModel{}
+	if err := readTemplateSchedules(d.client, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Template",
+			"Could not read project template schedules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err := readTemplateBuildTemplateName(d.client, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Template",
+			"Could not read project build template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -39,11 +39,12 @@ resource "semaphoreui_project_inventory" "test" {
 resource "semaphoreui_project_environment" "test" {
   project_id = semaphoreui_project.test.id
   name       = "Environment"
-  secrets = [{
-    name  = "SECRET_ONE"
-    type  = "var"
-    value = "VALUE_ONE"
-  }]
+  secrets = {
+    SECRET_ONE = {
+      type  = "var"
+      value = "VALUE_ONE"
+    }
+  }
 }
 
 resource "semaphoreui_project_view" "test" {
@@ -68,32 +69,38 @@ resource "semaphoreui_project_template" "test" {
   view_id = semaphoreui_project_view.test.id
   allow_override_args_in_task = true
 
-  survey_vars = [{
-    name     = "age"
-    title    = "What is your age?"
-    required = true
-    type     = "integer"
-    }, {
-    name  = "question"
-    title = "Pick one."
-    type  = "enum"
-    enum_values = {
-      "First Value"  = "1"
-      "Second Value" = "2"
+  survey_vars = {
+    age = {
+      title    = "What is your age?"
+      required = true
+      type     = "integer"
     }
-  }]
+    question = {
+      title = "Pick one."
+      type  = "enum"
+      enum_values = [{
+        name  = "First Value"
+        value = "1"
+        }, {
+        name  = "Second Value"
+        value = "2"
+      }]
+    }
+  }
 
-  vaults = [{
-    name = "" # default vault
-    password = {
-      vault_key_id = semaphoreui_project_key.test.id
+  vaults = {
+    # default vault
+    "" = {
+      password = {
+        value = "vault-password"
+      }
     }
-    }, {
-    name = "database"
-    script = {
-      script = "path/to/script-client.py"
+    database = {
+      client_script = {
+        script = "path/to/script-client.py"
+      }
     }
-  }]
+  }
 }
 
 data "semaphoreui_project_template" "test" {
@@ -135,11 +142,12 @@ resource "semaphoreui_project_inventory" "test" {
 resource "semaphoreui_project_environment" "test" {
   project_id = semaphoreui_project.test.id
   name       = "Environment"
-  secrets = [{
-    name  = "SECRET_ONE"
-    type  = "var"
-    value = "VALUE_ONE"
-  }]
+  secrets = {
+    SECRET_ONE = {
+      type  = "var"
+      value = "VALUE_ONE"
+    }
+  }
 }
 
 # Task Template
@@ -176,8 +184,10 @@ func TestAcc_ProjectTemplateDataSource_basicID(t *testing.T) {
 					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "playbook", "playbook.yml"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "description", "Description"),
 					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "arguments.#", "2"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "survey_vars.#", "2"),
-					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "vaults.#", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "survey_vars.%", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template.test", "vaults.%", "2"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_template.test", "vaults..password.vault_key_id", "semaphoreui_project_template.test", "vaults..password.vault_key_id"),
+					resource.TestCheckNoResourceAttr("data.semaphoreui_project_template.test", "vaults..password.value"),
 					resource.TestCheckNoResourceAttr("data.semaphoreui_project_template.test", "build"),
 					resource.TestCheckNoResourceAttr("data.semaphoreui_project_template.test", "deploy"),
 					resource.TestCheckResourceAttrSet("data.semaphoreui_project_template.test", "id"),
@@ -1,21 +1,41 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"sort"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
 	"terraform-provider-semaphoreui/semaphoreui/models"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &projectTemplateResource{}
-	_ resource.ResourceWithConfigure   = &projectTemplateResource{}
-	_ resource.ResourceWithImportState = &projectTemplateResource{}
+	_ resource.Resource                     = &projectTemplateResource{}
+	_ resource.ResourceWithConfigure        = &projectTemplateResource{}
+	_ resource.ResourceWithImportState      = &projectTemplateResource{}
+	_ resource.ResourceWithModifyPlan       = &projectTemplateResource{}
+	_ resource.ResourceWithConfigValidators = &projectTemplateResource{}
+	_ resource.ResourceWithUpgradeState     = &projectTemplateResource{}
+	_ resource.ConfigValidator              = templateTaskParamsValidator{}
+	_ resource.ConfigValidator              = templateSurveyVarsValidator{}
 )
 
 func NewProjectTemplateResource() resource.Resource {
@@ -31,16 +51,16 @@ func (r *projectTemplateResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,19 +69,239 @@ func (r *projectTemplateResource) Metadata(_ context.Context, req resource.Metad
 
 func (r *projectTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = ProjectTemplateSchema().GetResource(ctx)
+	resp.Schema.Version = 2
+}
+
+func (r *projectTemplateResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeRawState(upgradeProjectTemplateStateV0, upgradeProjectTemplateStateV1)},
+		1: {StateUpgrader: upgradeRawState(upgradeProjectTemplateStateV1)},
+	}
+}
+
+// upgradeProjectTemplateStateV0 converts the survey variable enum values from a map to a list of name/value objects.
+// The map does not keep the order of the enum values, so they are sorted by name.
+func upgradeProjectTemplateStateV0(state map[string]any) error {
+	surveyVars, _ := state["survey_vars"].([]any)
+	for _, surveyVar := range surveyVars {
+		surveyVar, ok := surveyVar.(map[string]any)
+		if !ok {
+			continue
+		}
+		enumValues, ok := surveyVar["enum_values"].(map[string]any)
+		if !ok {
+			continue
+		}
+		values := []any{}
+		for _, name := range slices.Sorted(maps.Keys(enumValues)) {
+			values = append(values, map[string]any{"name": name, "value": enumValues[name]})
+		}
+		surveyVar["enum_values"] = values
+	}
+	return nil
+}
+
+// upgradeProjectTemplateStateV1 converts the survey variables and vaults from lists to maps by name. The survey
+// variables get their position in the list, unless the list is in the order of their names.
+func upgradeProjectTemplateStateV1(state map[string]any) error {
+	if surveyVars, ok := state["survey_vars"].([]any); ok {
+		names := make([]string, 0, len(surveyVars))
+		for _, surveyVar := range surveyVars {
+			if surveyVar, ok := surveyVar.(map[string]any); ok {
+				name, _ := surveyVar["name"].(string)
+				names = append(names, name)
+			}
+		}
+		if !slices.IsSorted(names) {
+			for i, surveyVar := range surveyVars {
+				if surveyVar, ok := surveyVar.(map[string]any); ok {
+					surveyVar["position"] = i + 1
+				}
+			}
+		}
+	}
+	for _, attribute := range []string{"survey_vars", "vaults"} {
+		value, err := convertStateListToMap(state[attribute], "name")
+		if err != nil {
+			return fmt.Errorf("%s: %w", attribute, err)
+		}
+		state[attribute] = value
+	}
+	return nil
+}
+
+// sortTemplateSurveyVarNames returns the names of the survey variables in the order they are presented, by position then
+// by name, with the variables without a position after the others.
+func sortTemplateSurveyVarNames(surveyVars map[string]ProjectTemplateSurveyVarModel) []string {
+	hasPosition := func(surveyVar ProjectTemplateSurveyVarModel) bool {
+		return !surveyVar.Position.IsNull() && !surveyVar.Position.IsUnknown()
+	}
+	return slices.SortedFunc(maps.Keys(surveyVars), func(a, b string) int {
+		surveyVarA, surveyVarB := surveyVars[a], surveyVars[b]
+		switch {
+		case hasPosition(surveyVarA) && !hasPosition(surveyVarB):
+			return -1
+		case !hasPosition(surveyVarA) && hasPosition(surveyVarB):
+			return 1
+		case hasPosition(surveyVarA) && surveyVarA.Position.ValueInt64() != surveyVarB.Position.ValueInt64():
+			return cmp.Compare(surveyVarA.Position.ValueInt64(), surveyVarB.Position.ValueInt64())
+		}
+		return strings.Compare(a, b)
+	})
+}
+
+// templateShellApps are the applications that run a script with an interpreter.
+var templateShellApps = []string{"bash", "powershell", "python"}
+
+// templateTaskParamsAttribute returns the attribute of the task parameters of the app, or an empty string when the app
+// has no task parameters.
+func templateTaskParamsAttribute(app string) string {
+	switch {
+	case app == "" || app == "ansible":
+		return "ansible"
+	case slices.Contains(templateTerraformApps, app):
+		return "terraform"
+	case slices.Contains(templateShellApps, app):
+		return "shell"
+	}
+	return ""
+}
+
+// templateTaskParamsValidator checks that only the task parameters of the app of the template are set.
+type templateTaskParamsValidator struct{}
+
+func (v templateTaskParamsValidator) Description(_ context.Context) string {
+	return "Only the task parameters of the template app can be set."
+}
+
+func (v templateTaskParamsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v templateTaskParamsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var app types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("app"), &app)...)
+	if resp.Diagnostics.HasError() || app.IsUnknown() {
+		return
+	}
+	allowed := templateTaskParamsAttribute(app.ValueString())
+	for _, attribute := range []string{"ansible", "terraform", "shell"} {
+		var params types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &params)...)
+		if attribute == allowed || params.IsNull() || params.IsUnknown() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid SemaphoreUI Template Task Parameters",
+			fmt.Sprintf("The %s task parameters can not be set on a template with the app %q.", attribute, app.ValueString()),
+		)
+	}
+}
+
+// templateSurveyVarsValidator checks that the default value of the survey variables matches their type.
+type templateSurveyVarsValidator struct{}
+
+func (v templateSurveyVarsValidator) Description(_ context.Context) string {
+	return "The default value of a survey variable must match its type."
+}
+
+func (v templateSurveyVarsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v templateSurveyVarsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var surveyVars types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("survey_vars"), &surveyVars)...)
+	if resp.Diagnostics.HasError() || surveyVars.IsNull() || surveyVars.IsUnknown() {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(surveyVars.Elements())) {
+		surveyVarPath := path.Root("survey_vars").AtMapKey(name)
+		var varType, defaultValue types.String
+		diags := req.Config.GetAttribute(ctx, surveyVarPath.AtName("type"), &varType)
+		diags.Append(req.Config.GetAttribute(ctx, surveyVarPath.AtName("default_value"), &defaultValue)...)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || varType.IsUnknown() || defaultValue.IsNull() || defaultValue.IsUnknown() {
+			continue
+		}
+
+		switch varType.ValueString() {
+		case "integer":
+			if _, err := strconv.ParseInt(defaultValue.ValueString(), 10, 64); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					surveyVarPath.AtName("default_value"),
+					"Invalid SemaphoreUI Template Survey Variable",
+					fmt.Sprintf("The default value %q of an integer survey variable must be an integer.", defaultValue.ValueString()),
+				)
+			}
+		case "enum":
+			var enumValues types.List
+			diags = req.Config.GetAttribute(ctx, surveyVarPath.AtName("enum_values"), &enumValues)
+			if diags.HasError() || enumValues.IsNull() || enumValues.IsUnknown() {
+				resp.Diagnostics.Append(diags...)
+				continue
+			}
+			var values []string
+			known := true
+			for j := range enumValues.Elements() {
+				var value types.String
+				diags.Append(req.Config.GetAttribute(ctx, surveyVarPath.AtName("enum_values").AtListIndex(j).AtName("value"), &value)...)
+				known = known && !value.IsUnknown()
+				values = append(values, value.ValueString())
+			}
+			resp.Diagnostics.Append(diags...)
+			if !diags.HasError() && known && !slices.Contains(values, defaultValue.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					surveyVarPath.AtName("default_value"),
+					"Invalid SemaphoreUI Template Survey Variable",
+					fmt.Sprintf("The default value %q of an enum survey variable must be one of the enum values: %s.", defaultValue.ValueString(), strings.Join(values, ", ")),
+				)
+			}
+		}
+	}
+}
+
+func (r *projectTemplateResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{templateTaskParamsValidator{}, templateSurveyVarsValidator{}}
+}
+
+// convertStringListToStrings returns the strings of a list, or nil when the list is null or unknown.
+func convertStringListToStrings(ctx context.Context, list types.List) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var values []string
+	list.ElementsAs(ctx, &values, false)
+	return values
+}
+
+// convertStringsToStringList returns the list of the strings. No strings keep an empty previous list, and are null
+// otherwise.
+func convertStringsToStringList(ctx context.Context, values []string, prev types.List) types.List {
+	if len(values) == 0 {
+		if !prev.IsNull() && !prev.IsUnknown() && len(prev.Elements()) == 0 {
+			return prev
+		}
+		return types.ListNull(types.StringType)
+	}
+	list, _ := types.ListValueFrom(ctx, types.StringType, values)
+	return list
 }
 
 func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template ProjectTemplateModel) *models.TemplateRequest {
 	model := models.TemplateRequest{
-		ProjectID:               template.ProjectID.ValueInt64(),
-		EnvironmentID:           template.EnvironmentID.ValueInt64(),
-		InventoryID:             template.InventoryID.ValueInt64(),
-		RepositoryID:            template.RepositoryID.ValueInt64(),
-		App:                     template.App.ValueString(),
-		Name:                    template.Name.ValueString(),
-		Playbook:                template.Playbook.ValueString(),
-		AllowOverrideArgsInTask: template.AllowOverrideArgsInTask.ValueBool(),
-		SuppressSuccessAlerts:   template.SuppressSuccessAlerts.ValueBool(),
+		ProjectID:                    template.ProjectID.ValueInt64(),
+		EnvironmentID:                template.EnvironmentID.ValueInt64(),
+		InventoryID:                  template.InventoryID.ValueInt64(),
+		RepositoryID:                 template.RepositoryID.ValueInt64(),
+		App:                          template.App.ValueString(),
+		Name:                         template.Name.ValueString(),
+		Playbook:                     template.Playbook.ValueString(),
+		AllowOverrideArgsInTask:      template.AllowOverrideArgsInTask.ValueBool(),
+		AllowOverrideBranchInTask:    template.AllowOverrideBranchInTask.ValueBool(),
+		AllowOverrideInventoryInTask: template.AllowOverrideInventoryInTask.ValueBool(),
+		SuppressSuccessAlerts:        template.SuppressSuccessAlerts.ValueBool(),
 	}
 	if !template.ID.IsNull() && !template.ID.IsUnknown() {
 		model.ID = template.ID.ValueInt64()
@@ -99,13 +339,38 @@ func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template
 		model.Autorun = template.Deploy.Autorun.ValueBool()
 	}
 
+	switch {
+	case template.Ansible != nil:
+		model.TaskParams = &models.TemplateTaskParams{
+			Limit:    convertStringListToStrings(ctx, template.Ansible.Limit),
+			Tags:     convertStringListToStrings(ctx, template.Ansible.Tags),
+			SkipTags: convertStringListToStrings(ctx, template.Ansible.SkipTags),
+			Debug:    template.Ansible.Debug.ValueBool(),
+			Diff:     template.Ansible.Diff.ValueBool(),
+
+			AllowOverrideLimit: template.Ansible.AllowOverrideLimit.ValueBool(),
+			AllowOverrideTags:  template.Ansible.AllowOverrideTags.ValueBool(),
+		}
+	case template.Terraform != nil:
+		model.TaskParams = &models.TemplateTaskParams{
+			Plan:        template.Terraform.PlanOnly.ValueBool(),
+			AutoApprove: template.Terraform.AutoApprove.ValueBool(),
+			Upgrade:     template.Terraform.Upgrade.ValueBool(),
+		}
+	case template.Shell != nil:
+		model.TaskParams = &models.TemplateTaskParams{
+			InterpreterArgs: convertStringListToStrings(ctx, template.Shell.InterpreterArgs),
+		}
+	}
+
 	model.SurveyVars = []*models.TemplateSurveyVar{}
 	if !template.SurveyVars.IsNull() && !template.SurveyVars.IsUnknown() {
-		var surveyVars []ProjectTemplateSurveyVarModel
+		var surveyVars map[string]ProjectTemplateSurveyVarModel
 		template.SurveyVars.ElementsAs(ctx, &surveyVars, false)
-		for _, surveyVar := range surveyVars {
+		for _, name := range sortTemplateSurveyVarNames(surveyVars) {
+			surveyVar := surveyVars[name]
 			surveyVarModel := models.TemplateSurveyVar{
-				Name:     surveyVar.Name.ValueString(),
+				Name:     name,
 				Title:    surveyVar.Title.ValueString(),
 				Required: surveyVar.Required.ValueBool(),
 				Type:     surveyVar.Type.ValueString(),
@@ -113,11 +378,14 @@ func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template
 			if !surveyVar.Description.IsNull() && !surveyVar.Description.IsUnknown() {
 				surveyVarModel.Description = surveyVar.Description.ValueString()
 			}
+			if !surveyVar.DefaultValue.IsNull() && !surveyVar.DefaultValue.IsUnknown() {
+				surveyVarModel.DefaultValue = surveyVar.DefaultValue.ValueString()
+			}
 			if surveyVar.Type.ValueString() == "enum" {
-				for name, value := range surveyVar.EnumValues {
+				for _, value := range surveyVar.EnumValues {
 					surveyVarModel.Values = append(surveyVarModel.Values, &models.TemplateSurveyVarValue{
-						Name:  name,
-						Value: value,
+						Name:  value.Name.ValueString(),
+						Value: value.Value.ValueString(),
 					})
 				}
 			}
@@ -126,12 +394,13 @@ func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template
 	}
 
 	model.Vaults = []*models.TemplateVault{}
-	if !template.Vaults.IsNull() || !template.Vaults.IsUnknown() {
-		var vaults []ProjectTemplateVaultModel
+	if !template.Vaults.IsNull() && !template.Vaults.IsUnknown() {
+		var vaults map[string]ProjectTemplateVaultModel
 		template.Vaults.ElementsAs(ctx, &vaults, false)
-		for _, vault := range vaults {
+		for _, name := range slices.Sorted(maps.Keys(vaults)) {
+			vault := vaults[name]
 			vaultModel := models.TemplateVault{
-				Name: vault.Name.ValueString(),
+				Name: name,
 			}
 			if !vault.ID.IsNull() && !vault.ID.IsUnknown() {
 				vaultModel.ID = vault.ID.ValueInt64()
@@ -151,26 +420,20 @@ func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template
 	return &model
 }
 
-var _ sort.Interface = ByVaultID{}
-
-type ByVaultID []*models.TemplateVault
-
-func (a ByVaultID) Len() int           { return len(a) }
-func (a ByVaultID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a ByVaultID) Less(i, j int) bool { return a[i].ID < a[j].ID }
-
 func convertTemplateResponseToProjectTemplateModel(ctx context.Context, request *models.Template, prev *ProjectTemplateModel) ProjectTemplateModel {
 	model := ProjectTemplateModel{
-		ID:                      types.Int64Value(request.ID),
-		ProjectID:               types.Int64Value(request.ProjectID),
-		EnvironmentID:           types.Int64Value(request.EnvironmentID),
-		InventoryID:             types.Int64Value(request.InventoryID),
-		RepositoryID:            types.Int64Value(request.RepositoryID),
-		App:                     types.StringValue(request.App),
-		Name:                    types.StringValue(request.Name),
-		Playbook:                types.StringValue(request.Playbook),
-		AllowOverrideArgsInTask: types.BoolValue(request.AllowOverrideArgsInTask),
-		SuppressSuccessAlerts:   types.BoolValue(request.SuppressSuccessAlerts),
+		ID:                           types.Int64Value(request.ID),
+		ProjectID:                    types.Int64Value(request.ProjectID),
+		EnvironmentID:                types.Int64Value(request.EnvironmentID),
+		InventoryID:                  types.Int64Value(request.InventoryID),
+		RepositoryID:                 types.Int64Value(request.RepositoryID),
+		App:                          types.StringValue(request.App),
+		Name:                         types.StringValue(request.Name),
+		Playbook:                     types.StringValue(request.Playbook),
+		AllowOverrideArgsInTask:      types.BoolValue(request.AllowOverrideArgsInTask),
+		AllowOverrideBranchInTask:    types.BoolValue(request.AllowOverrideBranchInTask),
+		AllowOverrideInventoryInTask: types.BoolValue(request.AllowOverrideInventoryInTask),
+		SuppressSuccessAlerts:        types.BoolValue(request.SuppressSuccessAlerts),
 	}
 
 	if request.Description != "" {
@@ -219,18 +482,78 @@ func convertTemplateResponseToProjectTemplateModel(ctx context.Context, request
 
 	if request.Type == "deploy" {
 		model.Deploy = &ProjectTemplateTypeDeployModel{
-			BuildTemplateID: types.Int64Value(request.BuildTemplateID),
-			Autorun:         types.BoolValue(request.Autorun),
+			BuildTemplateID:   types.Int64Value(request.BuildTemplateID),
+			BuildTemplateName: types.StringNull(),
+			Autorun:           types.BoolValue(request.Autorun),
+		}
+		if prev.Deploy != nil {
+			model.Deploy.BuildTemplateName = prev.Deploy.BuildTemplateName
+		}
+	}
+
+	// The task parameters are read into the attribute of the app, when they are set or were configured
+	params := request.TaskParams
+	if params == nil {
+		params = &models.TemplateTaskParams{}
+	}
+	switch templateTaskParamsAttribute(request.App) {
+	case "ansible":
+		if prev.Ansible != nil || len(params.Limit) != 0 || len(params.Tags) != 0 || len(params.SkipTags) != 0 || params.Debug || params.Diff ||
+			params.AllowOverrideLimit || params.AllowOverrideTags {
+			var prevAnsible ProjectTemplateAnsibleModel
+			if prev.Ansible != nil {
+				prevAnsible = *prev.Ansible
+			}
+			model.Ansible = &ProjectTemplateAnsibleModel{
+				Limit:    convertStringsToStringList(ctx, params.Limit, prevAnsible.Limit),
+				Tags:     convertStringsToStringList(ctx, params.Tags, prevAnsible.Tags),
+				SkipTags: convertStringsToStringList(ctx, params.SkipTags, prevAnsible.SkipTags),
+				Debug:    types.BoolValue(params.Debug),
+				Diff:     types.BoolValue(params.Diff),
+
+				AllowOverrideLimit: types.BoolValue(params.AllowOverrideLimit),
+				AllowOverrideTags:  types.BoolValue(params.AllowOverrideTags),
+			}
+		}
+	case "terraform":
+		if prev.Terraform != nil || params.Plan || params.AutoApprove || params.Upgrade {
+			model.Terraform = &ProjectTemplateTerraformModel{
+				PlanOnly:    types.BoolValue(params.Plan),
+				AutoApprove: types.BoolValue(params.AutoApprove),
+				Upgrade:     types.BoolValue(params.Upgrade),
+			}
+		}
+	case "shell":
+		if prev.Shell != nil || len(params.InterpreterArgs) != 0 {
+			var prevShell ProjectTemplateShellModel
+			if prev.Shell != nil {
+				prevShell = *prev.Shell
+			}
+			model.Shell = &ProjectTemplateShellModel{
+				InterpreterArgs: convertStringsToStringList(ctx, params.InterpreterArgs, prevShell.InterpreterArgs),
+			}
 		}
 	}
 
 	if len(request.SurveyVars) == 0 {
 		model.SurveyVars = prev.SurveyVars
 	} else {
-		var surveyVars []ProjectTemplateSurveyVarModel
+		var prevSurveyVars map[string]ProjectTemplateSurveyVarModel
+		if !prev.SurveyVars.IsNull() && !prev.SurveyVars.IsUnknown() {
+			prev.SurveyVars.ElementsAs(ctx, &prevSurveyVars, false)
+		}
+		// The positions of the previous survey variables are kept when they give the same order
+		names := make([]string, 0, len(request.SurveyVars))
+		prevPositions := map[string]ProjectTemplateSurveyVarModel{}
 		for _, surveyVar := range request.SurveyVars {
+			names = append(names, surveyVar.Name)
+			prevPositions[surveyVar.Name] = ProjectTemplateSurveyVarModel{Position: prevSurveyVars[surveyVar.Name].Position}
+		}
+		keepPositions := slices.Equal(names, sortTemplateSurveyVarNames(prevPositions))
+
+		surveyVars := map[string]ProjectTemplateSurveyVarModel{}
+		for i, surveyVar := range request.SurveyVars {
 			surveyVarModel := ProjectTemplateSurveyVarModel{
-				Name:     types.StringValue(surveyVar.Name),
 				Title:    types.StringValue(surveyVar.Title),
 				Required: types.BoolValue(surveyVar.Required),
 				Type:     types.StringValue(surveyVar.Type),
@@ -238,33 +561,49 @@ func convertTemplateResponseToProjectTemplateModel(ctx context.Context, request
 			if surveyVar.Description != "" {
 				surveyVarModel.Description = types.StringValue(surveyVar.Description)
 			}
+			if surveyVar.DefaultValue != "" {
+				surveyVarModel.DefaultValue = types.StringValue(surveyVar.DefaultValue)
+			}
 			if surveyVar.Type == "enum" {
-				enumValuesMap := map[string]string{}
 				for _, value := range surveyVar.Values {
-					enumValuesMap[value.Name] = value.Value
+					surveyVarModel.EnumValues = append(surveyVarModel.EnumValues, ProjectTemplateSurveyVarValueModel{
+						Name:  types.StringValue(value.Name),
+						Value: types.StringValue(value.Value),
+					})
 				}
-				surveyVarModel.EnumValues = enumValuesMap
 			}
-			surveyVars = append(surveyVars, surveyVarModel)
+			if keepPositions {
+				surveyVarModel.Position = prevPositions[surveyVar.Name].Position
+			} else {
+				surveyVarModel.Position = types.Int64Value(int64(i + 1))
+			}
+			surveyVars[surveyVar.Name] = surveyVarModel
 		}
-		surveyVarsModel, _ := types.ListValueFrom(ctx, ProjectTemplateSurveyVarType, &surveyVars)
+		surveyVarsModel, _ := types.MapValueFrom(ctx, ProjectTemplateSurveyVarType, surveyVars)
 		model.SurveyVars = surveyVarsModel
 	}
 
 	if len(request.Vaults) == 0 {
 		model.Vaults = prev.Vaults
 	} else {
-		sort.Sort(ByVaultID(request.Vaults))
-
-		var vaults []ProjectTemplateVaultModel
+		prevVaults := templateVaults(ctx, prev)
+		vaults := map[string]ProjectTemplateVaultModel{}
 		for _, vault := range request.Vaults {
 			vaultModel := ProjectTemplateVaultModel{
-				ID:   types.Int64Value(vault.ID),
-				Name: types.StringValue(vault.Name),
+				ID: types.Int64Value(vault.ID),
 			}
 			if vault.Type == "password" {
 				vaultModel.Password = &ProjectTemplateVaultPasswordModel{
 					VaultKeyID: types.Int64Value(vault.VaultKeyID),
+					Value:      types.StringNull(),
+					File:       types.StringNull(),
+					FileHash:   types.StringNull(),
+				}
+				// The API doesn't return the source of the password of a managed key
+				if prevVault, ok := prevVaults[vault.Name]; ok && prevVault.Password != nil && prevVault.Password.VaultKeyID.Equal(vaultModel.Password.VaultKeyID) {
+					vaultModel.Password.Value = prevVault.Password.Value
+					vaultModel.Password.File = prevVault.Password.File
+					vaultModel.Password.FileHash = prevVault.Password.FileHash
 				}
 			}
 			if vault.Type == "script" {
@@ -272,32 +611,395 @@ func convertTemplateResponseToProjectTemplateModel(ctx context.Context, request
 					Script: types.StringValue(vault.Script),
 				}
 			}
-			vaults = append(vaults, vaultModel)
+			vaults[vault.Name] = vaultModel
 		}
-		vaultsModel, _ := types.ListValueFrom(ctx, ProjectTemplateVaultType, &vaults)
+		vaultsModel, _ := types.MapValueFrom(ctx, ProjectTemplateVaultType, vaults)
 		model.Vaults = vaultsModel
 	}
 
 	return model
 }
 
+func convertProjectTemplateScheduleToScheduleRequest(templateSchedule ProjectTemplateScheduleModel, projectID int64, templateID int64) *models.ScheduleRequest {
+	model := models.ScheduleRequest{
+		ProjectID:  projectID,
+		TemplateID: templateID,
+		Name:       templateSchedule.Name.ValueString(),
+		CronFormat: templateSchedule.CronFormat.ValueString(),
+		Active:     templateSchedule.Enabled.ValueBool(),
+	}
+	if runAt, err := time.Parse(time.RFC3339, templateSchedule.RunAt.ValueString()); err == nil {
+		model.Type = models.ScheduleRequestTypeRunAt
+		dateTime := strfmt.DateTime(runAt)
+		model.RunAt = &dateTime
+	}
+	return &model
+}
+
+func convertScheduleResponseToProjectTemplateScheduleModel(response *models.Schedule) ProjectTemplateScheduleModel {
+	model := ProjectTemplateScheduleModel{
+		Name:       types.StringValue(response.Name),
+		CronFormat: types.StringNull(),
+		RunAt:      types.StringNull(),
+		Enabled:    types.BoolValue(response.Active),
+	}
+	if response.Type == models.ScheduleTypeRunAt && response.RunAt != nil {
+		model.RunAt = types.StringValue(time.Time(*response.RunAt).UTC().Format(time.RFC3339))
+	} else {
+		model.CronFormat = types.StringValue(response.CronFormat)
+	}
+	return model
+}
+
+// templateScheduleMatches returns whether the schedule response is the template schedule. The run at times match
+// when they are the same instant, and run_at schedules that SemaphoreUI disabled once they have run match whether
+// they are enabled or not.
+func templateScheduleMatches(response *models.Schedule, templateSchedule ProjectTemplateScheduleModel) bool {
+	model := convertScheduleResponseToProjectTemplateScheduleModel(response)
+	if !model.Name.Equal(templateSchedule.Name) || !model.CronFormat.Equal(templateSchedule.CronFormat) {
+		return false
+	}
+	if !model.RunAt.IsNull() {
+		runAt, err := time.Parse(time.RFC3339, templateSchedule.RunAt.ValueString())
+		if err != nil || !runAt.Equal(time.Time(*response.RunAt)) {
+			return false
+		}
+		if !response.Active && !runAt.After(time.Now()) {
+			return true
+		}
+	} else if !templateSchedule.RunAt.IsNull() {
+		return false
+	}
+	return model.Enabled.Equal(templateSchedule.Enabled)
+}
+
+// readTemplateSchedules sets the schedules of the template when they are managed with the template. Schedules that
+// match the previous schedules keep their previous values.
+func readTemplateSchedules(client *apiclient.SemaphoreUI, model *ProjectTemplateModel) error {
+	if model.Schedules == nil {
+		return nil
+	}
+	schedules, err := getTemplateSchedules(client, model.ProjectID.ValueInt64(), model.ID.ValueInt64())
+	if err != nil {
+		return err
+	}
+
+	prev := model.Schedules
+	model.Schedules = make([]ProjectTemplateScheduleModel, 0, len(schedules))
+	for _, response := range schedules {
+		index := slices.IndexFunc(prev, func(templateSchedule ProjectTemplateScheduleModel) bool {
+			return templateScheduleMatches(response, templateSchedule)
+		})
+		if index < 0 {
+			model.Schedules = append(model.Schedules, convertScheduleResponseToProjectTemplateScheduleModel(response))
+			continue
+		}
+		model.Schedules = append(model.Schedules, prev[index])
+		prev = slices.Delete(prev, index, index+1)
+	}
+	return nil
+}
+
+// readTemplateBuildTemplateName sets the name of the build template of a deploy template.
+func readTemplateBuildTemplateName(client *apiclient.SemaphoreUI, model *ProjectTemplateModel) error {
+	if model.Deploy == nil {
+		return nil
+	}
+	response, err := client.Project.GetProjectProjectIDTemplatesTemplateID(&project.GetProjectProjectIDTemplatesTemplateIDParams{
+		ProjectID:  model.ProjectID.ValueInt64(),
+		TemplateID: model.Deploy.BuildTemplateID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		return err
+	}
+	model.Deploy.BuildTemplateName = types.StringValue(response.Payload.Name)
+	return nil
+}
+
+// readTemplateSchedulesAfterError reads the schedules of the template after they could not be set, so the state has
+// the schedules that are on the server. The schedules are set to prev when they can not be read either.
+func readTemplateSchedulesAfterError(client *apiclient.SemaphoreUI, model *ProjectTemplateModel, prev []ProjectTemplateScheduleModel) {
+	if model.Schedules == nil {
+		return
+	}
+	if err := readTemplateSchedules(client, model); err != nil {
+		model.Schedules = prev
+	}
+}
+
+// setTemplateSchedules makes the schedules of the template match the plan when they are managed with the template, and
+// reads them back. Schedules that are already defined are kept, the others are updated in place when there are
+// schedules left to remove, and created otherwise.
+func setTemplateSchedules(client *apiclient.SemaphoreUI, model *ProjectTemplateModel) error {
+	if model.Schedules == nil {
+		return nil
+	}
+	projectID := model.ProjectID.ValueInt64()
+	templateID := model.ID.ValueInt64()
+	existing, err := getTemplateSchedules(client, projectID, templateID)
+	if err != nil {
+		return err
+	}
+
+	var missing []ProjectTemplateScheduleModel
+	for _, templateSchedule := range model.Schedules {
+		index := slices.IndexFunc(existing, func(response *models.Schedule) bool {
+			return templateScheduleMatches(response, templateSchedule)
+		})
+		if index < 0 {
+			missing = append(missing, templateSchedule)
+			continue
+		}
+		existing = slices.Delete(existing, index, index+1)
+	}
+
+	for _, templateSchedule := range missing {
+		request := convertProjectTemplateScheduleToScheduleRequest(templateSchedule, projectID, templateID)
+		if len(existing) > 0 {
+			request.ID = existing[0].ID
+			existing = existing[1:]
+			_, err := client.Schedule.PutProjectProjectIDSchedulesScheduleID(&schedule.PutProjectProjectIDSchedulesScheduleIDParams{
+				ProjectID:  projectID,
+				ScheduleID: request.ID,
+				Schedule:   request,
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("could not update template schedule %s: %s", templateSchedule.Name.ValueString(), err.Error())
+			}
+			continue
+		}
+		_, err := client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{
+			ProjectID: projectID,
+			Schedule:  request,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not create template schedule %s: %s", templateSchedule.Name.ValueString(), err.Error())
+		}
+	}
+
+	for _, response := range existing {
+		_, err := client.Schedule.DeleteProjectProjectIDSchedulesScheduleID(&schedule.DeleteProjectProjectIDSchedulesScheduleIDParams{
+			ProjectID:  projectID,
+			ScheduleID: response.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not remove template schedule %s: %s", response.Name, err.Error())
+		}
+	}
+	return readTemplateSchedules(client, model)
+}
+
+// templateVaultPasswordManaged returns whether the vault password is stored in a project key managed with the
+// template, which is the case when it has a value or a file.
+func templateVaultPasswordManaged(password *ProjectTemplateVaultPasswordModel) bool {
+	return password != nil && (!password.Value.IsNull() || !password.File.IsNull())
+}
+
+// templateVaults returns the vaults of the template by name, or nil when they are null or unknown.
+func templateVaults(ctx context.Context, template *ProjectTemplateModel) map[string]ProjectTemplateVaultModel {
+	if template == nil || template.Vaults.IsNull() || template.Vaults.IsUnknown() {
+		return nil
+	}
+	var vaults map[string]ProjectTemplateVaultModel
+	template.Vaults.ElementsAs(ctx, &vaults, false)
+	return vaults
+}
+
+// templateVaultKeyName returns the name of the project key managed for the password of a vault of the template.
+func templateVaultKeyName(templateName string, vaultName string) string {
+	if vaultName == "" {
+		return templateName + " vault password"
+	}
+	return fmt.Sprintf("%s vault %s password", templateName, vaultName)
+}
+
+// readTemplateVaultPasswordFile returns the vault password of the file, without the trailing newline.
+func readTemplateVaultPasswordFile(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	password := strings.TrimRight(string(content), "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("the vault password file %s is empty", file)
+	}
+	return password, nil
+}
+
+// templateVaultPasswordHash returns the hash of the vault password, the salt and the HMAC-SHA256 of the password keyed
+// with the salt, so the password can't be looked up from the hash stored in the state. A random salt is generated when
+// salt is nil.
+func templateVaultPasswordHash(password string, salt []byte) (string, error) {
+	if salt == nil {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+	}
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(password))
+	return hex.EncodeToString(salt) + "$" + hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// templateVaultPasswordHashMatches returns whether the hash is the hash of the vault password.
+func templateVaultPasswordHashMatches(hash string, password string) bool {
+	encodedSalt, _, ok := strings.Cut(hash, "$")
+	if !ok {
+		return false
+	}
+	salt, err := hex.DecodeString(encodedSalt)
+	if err != nil {
+		return false
+	}
+	expected, err := templateVaultPasswordHash(password, salt)
+	return err == nil && hmac.Equal([]byte(expected), []byte(hash))
+}
+
+// setTemplateVaultKeys creates or updates the project keys managed for the vault passwords of the plan, reading the
+// password files, and sets the key IDs and file hashes in the plan. The key of a vault that already has a managed key
+// in the state is updated in place, when the password or the template name changed. It returns the IDs of the keys it
+// created, so they can be removed when the template can't be saved.
+func setTemplateVaultKeys(ctx context.Context, client *apiclient.SemaphoreUI, plan *ProjectTemplateModel, state *ProjectTemplateModel) ([]int64, error) {
+	vaults := templateVaults(ctx, plan)
+	stateVaults := templateVaults(ctx, state)
+	var created []int64
+	for _, name := range slices.Sorted(maps.Keys(vaults)) {
+		vault := vaults[name]
+		if !templateVaultPasswordManaged(vault.Password) {
+			continue
+		}
+		password := vault.Password.Value.ValueString()
+		plannedHash := vault.Password.FileHash
+		vault.Password.FileHash = types.StringNull()
+		if !vault.Password.File.IsNull() {
+			var err error
+			password, err = readTemplateVaultPasswordFile(vault.Password.File.ValueString())
+			if err != nil {
+				return created, fmt.Errorf("could not read the password of vault %q: %s", name, err.Error())
+			}
+			vault.Password.FileHash = plannedHash
+			if plannedHash.IsUnknown() || !templateVaultPasswordHashMatches(plannedHash.ValueString(), password) {
+				hash, err := templateVaultPasswordHash(password, nil)
+				if err != nil {
+					return created, fmt.Errorf("could not hash the password of vault %q: %s", name, err.Error())
+				}
+				vault.Password.FileHash = types.StringValue(hash)
+			}
+		}
+
+		key := &models.AccessKeyRequest{
+			ProjectID: plan.ProjectID.ValueInt64(),
+			Name:      templateVaultKeyName(plan.Name.ValueString(), name),
+			Type:      ProjectKeyTypeLoginPassword,
+			LoginPassword: &models.AccessKeyRequestLoginPassword{
+				Password: password,
+			},
+		}
+		if stateVault, ok := stateVaults[name]; ok && templateVaultPasswordManaged(stateVault.Password) && plan.ProjectID.Equal(state.ProjectID) {
+			vault.Password.VaultKeyID = stateVault.Password.VaultKeyID
+			if !plan.Name.Equal(state.Name) || !vault.Password.Value.Equal(stateVault.Password.Value) || !vault.Password.FileHash.Equal(stateVault.Password.FileHash) {
+				key.ID = stateVault.Password.VaultKeyID.ValueInt64()
+				key.OverrideSecret = true
+				// The API only updates access keys when all the secret fields are set
+				key.SSH = &models.AccessKeyRequestSSH{}
+				_, err := client.Project.PutProjectProjectIDKeysKeyID(&project.PutProjectProjectIDKeysKeyIDParams{
+					ProjectID: key.ProjectID,
+					KeyID:     key.ID,
+					AccessKey: key,
+				}, nil)
+				if err != nil {
+					return created, fmt.Errorf("could not update the key of vault %q: %s", name, err.Error())
+				}
+			}
+		} else {
+			response, err := client.Project.PostProjectProjectIDKeys(&project.PostProjectProjectIDKeysParams{
+				ProjectID: key.ProjectID,
+				AccessKey: key,
+			}, nil)
+			if err != nil {
+				return created, fmt.Errorf("could not create the key of vault %q: %s", name, err.Error())
+			}
+			created = append(created, response.Payload.ID)
+			vault.Password.VaultKeyID = types.Int64Value(response.Payload.ID)
+		}
+		vaults[name] = vault
+	}
+	if vaults != nil {
+		vaultsModel, diags := types.MapValueFrom(ctx, ProjectTemplateVaultType, vaults)
+		if diags.HasError() {
+			return created, fmt.Errorf("could not set the vault keys of the template")
+		}
+		plan.Vaults = vaultsModel
+	}
+	return created, nil
+}
+
+// deleteTemplateVaultKeys removes the project keys managed for the vault passwords of the state that the plan no longer
+// uses, all of them when the plan is nil.
+func deleteTemplateVaultKeys(ctx context.Context, client *apiclient.SemaphoreUI, plan *ProjectTemplateModel, state *ProjectTemplateModel) error {
+	used := map[int64]bool{}
+	for _, vault := range templateVaults(ctx, plan) {
+		if templateVaultPasswordManaged(vault.Password) {
+			used[vault.Password.VaultKeyID.ValueInt64()] = true
+		}
+	}
+	stateVaults := templateVaults(ctx, state)
+	for _, name := range slices.Sorted(maps.Keys(stateVaults)) {
+		vault := stateVaults[name]
+		if !templateVaultPasswordManaged(vault.Password) || used[vault.Password.VaultKeyID.ValueInt64()] {
+			continue
+		}
+		if err := deleteProjectKeys(client, state.ProjectID.ValueInt64(), vault.Password.VaultKeyID.ValueInt64()); err != nil {
+			return fmt.Errorf("could not remove the key of vault %q: %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+// deleteProjectKeys removes project keys.
+func deleteProjectKeys(client *apiclient.SemaphoreUI, projectID int64, keyIDs ...int64) error {
+	for _, keyID := range keyIDs {
+		_, err := client.Project.DeleteProjectProjectIDKeysKeyID(&project.DeleteProjectProjectIDKeysKeyIDParams{
+			ProjectID: projectID,
+			KeyID:     keyID,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *projectTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectTemplateModel
+	var plan ProjectTemplateResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectTemplateNameReferences...)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	vaultKeyIDs, err := setTemplateVaultKeys(ctx, r.client, &plan.ProjectTemplateModel, nil)
+	if err != nil {
+		// Do not leave the keys of the vault passwords behind, they are not in the state
+		_ = deleteProjectKeys(r.client, plan.ProjectID.ValueInt64(), vaultKeyIDs...)
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Template Vault Password",
+			"Could not set project key of the template vault password, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	create, err := r.client.Project.PostProjectProjectIDTemplates(&project.PostProjectProjectIDTemplatesParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		Template:  convertProjectTemplateModelToTemplateRequest(ctx, plan),
+		Template:  convertProjectTemplateModelToTemplateRequest(ctx, plan.ProjectTemplateModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating SemaphoreUI Project Template",
 			"Could not create project template, unexpected error: "+err.Error(),
 		)
+		_ = deleteProjectKeys(r.client, plan.ProjectID.ValueInt64(), vaultKeyIDs...)
 		return
 	}
 
@@ -313,7 +1015,19 @@ func (r *projectTemplateResource) Create(ctx context.Context, req resource.Creat
 		)
 		return
 	}
-	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan)
+	model := plan
+	model.ProjectTemplateModel = convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan.ProjectTemplateModel)
+	model.Schedules = plan.Schedules
+	if err := setTemplateSchedules(r.client, &model.ProjectTemplateModel); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Template",
+			"Could not set project template schedules, unexpected error: "+err.Error(),
+		)
+		// Keep the created template in the state, so it is not created again
+		readTemplateSchedulesAfterError(r.client, &model.ProjectTemplateModel, []ProjectTemplateScheduleModel{})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
@@ -325,7 +1039,7 @@ func (r *projectTemplateResource) Create(ctx context.Context, req resource.Creat
 // Read refreshes the Terraform state with the latest data.
 func (r *projectTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectTemplateModel
+	var state ProjectTemplateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -342,7 +1056,16 @@ func (r *projectTemplateResource) Read(ctx context.Context, req resource.ReadReq
 		)
 		return
 	}
-	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &state)
+	model := state
+	model.ProjectTemplateModel = convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &state.ProjectTemplateModel)
+	model.Schedules = state.Schedules
+	if err := readTemplateSchedules(r.client, &model.ProjectTemplateModel); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Template",
+			"Could not read project template schedules, unexpected error: "+err.Error(),
+		)
+		return
+	}
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
@@ -354,22 +1077,44 @@ func (r *projectTemplateResource) Read(ctx context.Context, req resource.ReadReq
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectTemplateModel
+	var plan, state ProjectTemplateResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectTemplateNameReferences...)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.Project.PutProjectProjectIDTemplatesTemplateID(&project.PutProjectProjectIDTemplatesTemplateIDParams{
+	vaultKeyIDs, err := setTemplateVaultKeys(ctx, r.client, &plan.ProjectTemplateModel, &state.ProjectTemplateModel)
+	if err != nil {
+		_ = deleteProjectKeys(r.client, plan.ProjectID.ValueInt64(), vaultKeyIDs...)
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI Project Template Vault Password",
+			"Could not set project key of the template vault password, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.Project.PutProjectProjectIDTemplatesTemplateID(&project.PutProjectProjectIDTemplatesTemplateIDParams{
 		ProjectID:  plan.ProjectID.ValueInt64(),
 		TemplateID: plan.ID.ValueInt64(),
-		Template:   convertProjectTemplateModelToTemplateRequest(ctx, plan),
+		Template:   convertProjectTemplateModelToTemplateRequest(ctx, plan.ProjectTemplateModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating SemaphoreUI Project Template",
 			"Could not update project template, unexpected error: "+err.Error(),
 		)
+		_ = deleteProjectKeys(r.client, plan.ProjectID.ValueInt64(), vaultKeyIDs...)
+		return
+	}
+
+	// The keys of the vault passwords can only be removed once the template no longer uses them
+	if err := deleteTemplateVaultKeys(ctx, r.client, &plan.ProjectTemplateModel, &state.ProjectTemplateModel); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing SemaphoreUI Project Template Vault Password",
+			"Could not remove project key of the template vault password, unexpected error: "+err.Error(),
+		)
 		return
 	}
 
@@ -384,17 +1129,324 @@ func (r *projectTemplateResource) Update(ctx context.Context, req resource.Updat
 		)
 		return
 	}
-	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan)
+	model := plan
+	model.ProjectTemplateModel = convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan.ProjectTemplateModel)
+	model.Schedules = plan.Schedules
+	if err := setTemplateSchedules(r.client, &model.ProjectTemplateModel); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI Project Template",
+			"Could not set project template schedules, unexpected error: "+err.Error(),
+		)
+		// The template itself is updated, keep it in the state
+		prev := state.Schedules
+		if prev == nil {
+			prev = []ProjectTemplateScheduleModel{}
+		}
+		readTemplateSchedulesAfterError(r.client, &model.ProjectTemplateModel, prev)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// projectTemplateReferences returns the references of the template to the other objects of its project.
+func projectTemplateReferences(ctx context.Context, template *ProjectTemplateModel) []projectReference {
+	if template == nil {
+		return nil
+	}
+	references := []projectReference{
+		{path: path.Root("environment_id"), kind: projectEnvironmentKind, id: template.EnvironmentID},
+		{path: path.Root("inventory_id"), kind: projectInventoryKind, id: template.InventoryID},
+		{path: path.Root("repository_id"), kind: projectRepositoryKind, id: template.RepositoryID},
+		{path: path.Root("view_id"), kind: projectViewKind, id: template.ViewID},
+	}
+	if template.Deploy != nil {
+		references = append(references, projectReference{path: path.Root("deploy").AtName("build_template_id"), kind: projectTemplateKind, id: template.Deploy.BuildTemplateID})
+	}
+	if !template.Vaults.IsNull() && !template.Vaults.IsUnknown() {
+		var vaults map[string]ProjectTemplateVaultModel
+		template.Vaults.ElementsAs(ctx, &vaults, false)
+		for _, name := range slices.Sorted(maps.Keys(vaults)) {
+			if vault := vaults[name]; vault.Password != nil {
+				references = append(references, projectReference{path: path.Root("vaults").AtMapKey(name).AtName("password").AtName("vault_key_id"), kind: projectKeyKind, id: vault.Password.VaultKeyID})
+			}
+		}
+	}
+	return references
+}
+
+// templateTerraformApps are the applications that run Terraform workspaces. Their templates use a Terraform workspace
+// inventory, where ansible templates use any other kind of inventory.
+var templateTerraformApps = []string{"terraform", "tofu", "terragrunt"}
+
+// validateTemplateApp checks that the app of the template is an active application of the server, and that the
+// inventory and vaults of the template suit the app. The checks only run on a new or changed app, inventory or vaults,
+// so that the plan of an existing template doesn't fail when an application is deactivated.
+func validateTemplateApp(client *apiclient.SemaphoreUI, plan *ProjectTemplateModel, state *ProjectTemplateModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || plan.App.IsUnknown() {
+		return diags
+	}
+	app := plan.App.ValueString()
+	if app == "" {
+		app = "ansible"
+	}
+
+	appChanged := state == nil || !plan.App.Equal(state.App)
+	if appChanged {
+		apps, err := getServerApps(client)
+		if err != nil {
+			diags.AddError(
+				"Error Reading SemaphoreUI Apps",
+				"Could not check the app of the template, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		var active []string
+		var found *models.App
+		for _, serverApp := range apps {
+			if serverApp.Active {
+				active = append(active, serverApp.ID)
+			}
+			if serverApp.ID == app {
+				found = serverApp
+			}
+		}
+		if found == nil {
+			diags.AddAttributeError(path.Root("app"),
+				"Invalid SemaphoreUI Template App",
+				fmt.Sprintf("The app %q is not an application of the SemaphoreUI server. Active applications: %s.", app, strings.Join(active, ", ")),
+			)
+			return diags
+		}
+		if !found.Active {
+			diags.AddAttributeError(path.Root("app"),
+				"Invalid SemaphoreUI Template App",
+				fmt.Sprintf("The app %q is not active on the SemaphoreUI server. Activate it, or use one of the active applications: %s.", app, strings.Join(active, ", ")),
+			)
+			return diags
+		}
+	}
+
+	if app != "ansible" && !plan.Vaults.IsUnknown() && len(plan.Vaults.Elements()) > 0 && (appChanged || !plan.Vaults.Equal(state.Vaults)) {
+		diags.AddAttributeError(path.Root("vaults"),
+			"Invalid SemaphoreUI Template Vaults",
+			fmt.Sprintf("Vaults are only used by ansible templates, the app of the template is %q.", app),
+		)
+	}
+
+	terraform := slices.Contains(templateTerraformApps, app)
+	if app != "ansible" && !terraform {
+		return diags
+	}
+	if plan.ProjectID.IsUnknown() || plan.InventoryID.IsUnknown() || plan.InventoryID.IsNull() {
+		return diags
+	}
+	if !appChanged && plan.InventoryID.Equal(state.InventoryID) {
+		return diags
+	}
+	response, err := client.Project.GetProjectProjectIDInventory(&project.GetProjectProjectIDInventoryParams{ProjectID: plan.ProjectID.ValueInt64()}, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Reading SemaphoreUI Project Inventories",
+			"Could not check the inventory of the template, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	for _, inventory := range response.Payload {
+		if inventory.ID != plan.InventoryID.ValueInt64() {
+			continue
+		}
+		workspace := inventory.Type == models.InventoryTypeTerraformDashWorkspace
+		if terraform && !workspace {
+			diags.AddAttributeError(path.Root("inventory_id"),
+				"Invalid SemaphoreUI Template Inventory",
+				fmt.Sprintf("The inventory %d is not a Terraform workspace, %s templates use a `terraform_workspace` inventory.", inventory.ID, app),
+			)
+		} else if !terraform && workspace {
+			diags.AddAttributeError(path.Root("inventory_id"),
+				"Invalid SemaphoreUI Template Inventory",
+				fmt.Sprintf("The inventory %d is a Terraform workspace, ansible templates use a static, static YAML or file inventory.", inventory.ID),
+			)
+		}
+	}
+	return diags
+}
+
+// planTemplateVaultKeys plans the IDs of the keys managed for the vault passwords, which are kept for the vaults that
+// already have a managed key, and the hashes of the password files, which are kept when the password of the file is
+// unchanged and unknown otherwise, a new salt being generated when the key is updated.
+func planTemplateVaultKeys(ctx context.Context, plan *ProjectTemplateModel, state *ProjectTemplateModel) diag.Diagnostics {
+	var vaults map[string]ProjectTemplateVaultModel
+	diags := plan.Vaults.ElementsAs(ctx, &vaults, false)
+	if diags.HasError() {
+		return diags
+	}
+	stateVaults := templateVaults(ctx, state)
+	for name, vault := range vaults {
+		if !templateVaultPasswordManaged(vault.Password) {
+			if vault.Password != nil {
+				vault.Password.FileHash = types.StringNull()
+			}
+			vaults[name] = vault
+			continue
+		}
+		vault.Password.VaultKeyID = types.Int64Unknown()
+		if stateVault, ok := stateVaults[name]; ok && templateVaultPasswordManaged(stateVault.Password) {
+			vault.Password.VaultKeyID = stateVault.Password.VaultKeyID
+		}
+		vault.Password.FileHash = types.StringNull()
+		if !vault.Password.File.IsNull() {
+			vault.Password.FileHash = types.StringUnknown()
+			if stateVault, ok := stateVaults[name]; ok && stateVault.Password != nil && !vault.Password.File.IsUnknown() && !stateVault.Password.FileHash.IsNull() {
+				if password, err := readTemplateVaultPasswordFile(vault.Password.File.ValueString()); err == nil && templateVaultPasswordHashMatches(stateVault.Password.FileHash.ValueString(), password) {
+					vault.Password.FileHash = stateVault.Password.FileHash
+				}
+			}
+		}
+		vaults[name] = vault
+	}
+	plan.Vaults, diags = types.MapValueFrom(ctx, ProjectTemplateVaultType, vaults)
+	return diags
+}
+
+// validateTemplateVaultKeys checks that the keys of the vault passwords are login_password keys, the password of the
+// key being the vault password. The checks only run on new or changed keys, and not on the keys managed for the vault
+// passwords.
+func validateTemplateVaultKeys(ctx context.Context, client *apiclient.SemaphoreUI, plan *ProjectTemplateModel, state *ProjectTemplateModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || plan.ProjectID.IsUnknown() {
+		return diags
+	}
+	vaults := templateVaults(ctx, plan)
+	stateVaults := templateVaults(ctx, state)
+	var keys []*models.AccessKey
+	for _, name := range slices.Sorted(maps.Keys(vaults)) {
+		vault := vaults[name]
+		if vault.Password == nil || templateVaultPasswordManaged(vault.Password) || vault.Password.VaultKeyID.IsNull() || vault.Password.VaultKeyID.IsUnknown() {
+			continue
+		}
+		if stateVault, ok := stateVaults[name]; ok && stateVault.Password != nil && plan.ProjectID.Equal(state.ProjectID) && vault.Password.VaultKeyID.Equal(stateVault.Password.VaultKeyID) {
+			continue
+		}
+		if keys == nil {
+			response, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{ProjectID: plan.ProjectID.ValueInt64()}, nil)
+			if err != nil {
+				diags.AddError(
+					"Error Reading SemaphoreUI Project Keys",
+					"Could not check the vault keys of the template, unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			keys = response.Payload
+		}
+		for _, key := range keys {
+			if key.ID == vault.Password.VaultKeyID.ValueInt64() && key.Type != ProjectKeyTypeLoginPassword {
+				diags.AddAttributeError(path.Root("vaults").AtMapKey(name).AtName("password").AtName("vault_key_id"),
+					"Invalid SemaphoreUI Template Vault Key",
+					fmt.Sprintf("The key %q is a %s key, vault passwords use a %s key, whose password is the vault password.", key.Name, key.Type, ProjectKeyTypeLoginPassword),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// validateTemplateBuild checks that the build template of a deploy template is a build template. The check only runs on
+// a new or changed build template, the project of the build template is checked with the other project references.
+func validateTemplateBuild(client *apiclient.SemaphoreUI, plan *ProjectTemplateModel, state *ProjectTemplateModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || plan.Deploy == nil || plan.ProjectID.IsUnknown() || plan.Deploy.BuildTemplateID.IsNull() || plan.Deploy.BuildTemplateID.IsUnknown() {
+		return diags
+	}
+	if state != nil && state.Deploy != nil && plan.ProjectID.Equal(state.ProjectID) && plan.Deploy.BuildTemplateID.Equal(state.Deploy.BuildTemplateID) {
+		return diags
+	}
+	buildTemplateID := plan.Deploy.BuildTemplateID.ValueInt64()
+	response, err := client.Project.GetProjectProjectIDTemplates(&project.GetProjectProjectIDTemplatesParams{ProjectID: plan.ProjectID.ValueInt64()}, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Reading SemaphoreUI Project Templates",
+			"Could not check the build template of the template, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	for _, template := range response.Payload {
+		if template.ID == buildTemplateID && template.Type != "build" {
+			diags.AddAttributeError(path.Root("deploy").AtName("build_template_id"),
+				"Invalid SemaphoreUI Build Template",
+				fmt.Sprintf("The template %d (%s) is not a build template. Deploy templates deploy the versions of a template with the `build` attributes.", buildTemplateID, template.Name),
+			)
+		}
+	}
+	return diags
+}
+
+// projectTemplateNameReferences are the name attributes of the template.
+var projectTemplateNameReferences = []projectNameReference{
+	projectNameReferenceOfProject,
+	{idPath: path.Root("environment_id"), namePath: path.Root("environment_name"), kind: projectEnvironmentKind},
+	{idPath: path.Root("inventory_id"), namePath: path.Root("inventory_name"), kind: projectInventoryKind},
+	{idPath: path.Root("repository_id"), namePath: path.Root("repository_name"), kind: projectRepositoryKind},
+	{idPath: path.Root("view_id"), namePath: path.Root("view_name"), kind: projectViewKind},
+	{idPath: path.Root("deploy").AtName("build_template_id"), namePath: path.Root("deploy").AtName("build_template_name"), kind: projectTemplateKind},
+}
+
+// ModifyPlan resolves the project, environment, inventory, repository, view and build template names of the template,
+// checks that the environment, inventory, repository, view, build template and vault keys of the template belong
+// to the project of the template, that the vault keys are login_password keys, that the build template of a deploy
+// template is a build template, and that the app of the template is an active application that suits its inventory
+// and vaults. It also plans the keys managed for the vault passwords.
+func (r *projectTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectTemplateNameReferences...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectTemplateResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *ProjectTemplateModel
+	stateProjectID := types.Int64Null()
+	if !req.State.Raw.IsNull() {
+		stateModel := ProjectTemplateResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state = &stateModel.ProjectTemplateModel
+		stateProjectID = state.ProjectID
+	}
+
+	if !plan.Vaults.IsNull() && !plan.Vaults.IsUnknown() {
+		resp.Diagnostics.Append(planTemplateVaultKeys(ctx, &plan.ProjectTemplateModel, state)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("vaults"), plan.Vaults)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateProjectReferences(r.client, plan.ProjectID, projectTemplateReferences(ctx, &plan.ProjectTemplateModel), stateProjectID, projectTemplateReferences(ctx, state))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateTemplateVaultKeys(ctx, r.client, &plan.ProjectTemplateModel, state)...)
+	resp.Diagnostics.Append(validateTemplateBuild(r.client, &plan.ProjectTemplateModel, state)...)
+	resp.Diagnostics.Append(validateTemplateApp(r.client, &plan.ProjectTemplateModel, state)...)
+}
+
 func (r *projectTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectTemplateModel
+	var state ProjectTemplateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -411,6 +1463,14 @@ func (r *projectTemplateResource) Delete(ctx context.Context, req resource.Delet
 		)
 		return
 	}
+
+	if err := deleteTemplateVaultKeys(ctx, r.client, nil, &state.ProjectTemplateModel); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing SemaphoreUI Project Template Vault Password",
+			"Could not remove project key of the template vault password, unexpected error: "+err.Error(),
+		)
+		return
+	}
 }
 
 func (r *projectTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -434,12 +1494,14 @@ func (r *projectTemplateResource) ImportState(ctx context.Context, req resource.
 		)
 		return
 	}
-	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &ProjectTemplateModel{
-		SurveyVars: types.ListNull(ProjectTemplateSurveyVarType),
-		Vaults:     types.ListNull(ProjectTemplateVaultType),
-	})
+	model := ProjectTemplateResourceModel{
+		ProjectTemplateModel: convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &ProjectTemplateModel{
+			SurveyVars: types.MapNull(ProjectTemplateSurveyVarType),
+			Vaults:     types.MapNull(ProjectTemplateVaultType),
+		}),
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"testing"
@@ -179,6 +182,8 @@ func TestAcc_ProjectTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "playbook", "playbook.yml"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "app", "ansible"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_args_in_task", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_branch_in_task", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_inventory_in_task", "false"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "suppress_success_alerts", "false"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "arguments"),
@@ -206,6 +211,8 @@ func TestAcc_ProjectTemplateResource_basic(t *testing.T) {
 			{
 				Config: testAccProjectTemplateConfig(nameSuffix, `
 allow_override_args_in_task = true
+allow_override_branch_in_task = true
+allow_override_inventory_in_task = true
 git_branch = "staging"
 arguments = [
   "--help",
@@ -219,6 +226,8 @@ view_id = semaphoreui_project_view.test.id
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "playbook", "playbook.yml"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "app", "ansible"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_args_in_task", "true"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_branch_in_task", "true"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_inventory_in_task", "true"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "suppress_success_alerts", "false"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "git_branch", "staging"),
 
@@ -354,7 +363,7 @@ func TestAcc_ProjectTemplateResource_basicDeploy(t *testing.T) {
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_args_in_task", "false"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "suppress_success_alerts", "false"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.%", "3"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "deploy.build_template_id"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.autorun", "false"),
 
@@ -400,7 +409,7 @@ arguments = [
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.0", "--help"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.1", "--verbose"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.%", "3"),
 					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "deploy.build_template_id"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.autorun", "false"),
 
@@ -432,24 +441,41 @@ func TestAcc_ProjectTemplateResource_surveyVars(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+survey_vars = {
+  var1 = {
+    title = "Variable 1"
+    type = "integer"
+    default_value = "many"
+  }
+}
+`),
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Template Survey Variable"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccProjectTemplateConfig(nameSuffix, `
-survey_vars = [{
-  name = "var1"
-  title = "Variable 1"
-  description = "Description 1"
-  type = "string"
-  required = true
-}, {
-  name = "var2"
-  title = "Variable 2"
-  type = "enum"
-  enum_values = {
-    "Option 1" = "opt1"
-    "Option 2" = "opt2" 
+survey_vars = {
+  var1 = {
+    title = "Variable 1"
+    description = "Description 1"
+    type = "string"
+    required = true
   }
-}]
+  var2 = {
+    title = "Variable 2"
+    type = "enum"
+    default_value = "opt2"
+    enum_values = [{
+      name = "Option 2"
+      value = "opt2"
+    }, {
+      name = "Option 1"
+      value = "opt1"
+    }]
+  }
+}
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
@@ -459,19 +485,23 @@ survey_vars = [{
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_args_in_task", "false"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "suppress_success_alerts", "false"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.#", "2"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.name", "var1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.title", "Variable 1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.description", "Description 1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.type", "string"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.required", "true"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.0.enum_values"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.name", "var2"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.title", "Variable 2"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.1.description"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.type", "enum"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.required", "false"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.enum_values.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.title", "Variable 1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.description", "Description 1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.type", "string"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.required", "true"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.enum_values"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.position"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.title", "Variable 2"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.description"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.type", "enum"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.required", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.default_value", "opt2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values.#", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values.0.name", "Option 2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values.0.value", "opt2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values.1.name", "Option 1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values.1.value", "opt1"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "arguments"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults"),
@@ -501,17 +531,21 @@ arguments = [
   "--help",
   "--verbose",
 ]
-survey_vars = [{
-  name = "var1"
-  title = "Variable 1"
-  description = "Description 1"
-  type = "integer"
-}, {
-  name = "var2"
-  title = "Variable 2"
-  type = "secret"
-  required = true
-}]
+survey_vars = {
+  var2 = {
+    title = "Variable 2"
+    type = "secret"
+    required = true
+    position = 1
+  }
+  var1 = {
+    title = "Variable 1"
+    description = "Description 1"
+    type = "integer"
+    default_value = "42"
+    position = 2
+  }
+}
 `),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
@@ -526,19 +560,20 @@ survey_vars = [{
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.0", "--help"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.1", "--verbose"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.#", "2"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.name", "var1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.title", "Variable 1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.description", "Description 1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.type", "integer"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.0.required", "false"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.0.enum_values"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.name", "var2"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.title", "Variable 2"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.1.description"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.type", "secret"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.1.required", "true"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.1.enum_values"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.title", "Variable 1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.description", "Description 1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.type", "integer"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.default_value", "42"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.required", "false"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.enum_values"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var1.position", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.title", "Variable 2"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.description"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.type", "secret"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.required", "true"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.enum_values"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "survey_vars.var2.position", "1"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "build"),
@@ -564,25 +599,61 @@ survey_vars = [{
 
 func TestAcc_ProjectTemplateResource_vaults(t *testing.T) {
 	nameSuffix := acctest.RandString(8)
+	passwordFile := filepath.Join(t.TempDir(), "vault-password")
+	if err := os.WriteFile(passwordFile, []byte("file-password\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	vault := fmt.Sprintf(`
+resource "semaphoreui_project_key" "vault" {
+  project_id = semaphoreui_project.test.id
+  name       = "Vault-%[1]s"
+  login_password = {
+    password = "password"
+  }
+}
+
+resource "semaphoreui_project_environment" "vault" {
+  project_id = semaphoreui_project.test.id
+  name       = "Vault-%[1]s"
+  secrets = {
+    VAULT_PASSWORD = {
+      type  = "env"
+      value = "secret-password"
+    }
+  }
+}`, nameSuffix)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+vaults = {
+  "" = {
+    password = {
+      vault_key_id = semaphoreui_project_key.test.id
+    }
+  }
+}
+`) + vault,
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Template Vault Key"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccProjectTemplateConfig(nameSuffix, `
-vaults = [{
-  name = ""
-  password = {
-    vault_key_id = semaphoreui_project_key.test.id
+vaults = {
+  "" = {
+    password = {
+      vault_key_id = semaphoreui_project_key.vault.id
+    }
   }
-}, {
-  name = "database"
-  client_script = {
-    script = "path/to/script-client.py"
+  database = {
+    client_script = {
+      script = "path/to/script-client.py"
+    }
   }
-}]
-`),
+}
+`) + vault,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
@@ -591,15 +662,14 @@ vaults = [{
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "allow_override_args_in_task", "false"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "suppress_success_alerts", "false"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.#", "2"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.0.name", ""),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.0.password.%", "1"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "vaults.0.password.vault_key_id"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.0.client_script"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.1.name", "database"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.1.client_script.%", "1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.1.client_script.script", "path/to/script-client.py"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.1.password"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults..password.%", "4"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "vaults..password.vault_key_id", "semaphoreui_project_key.vault", "id"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults..password.value"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults..client_script"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.database.client_script.%", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.database.client_script.script", "path/to/script-client.py"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.database.password"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "arguments"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars"),
@@ -629,13 +699,19 @@ arguments = [
   "--help",
   "--verbose",
 ]
-vaults = [{
-  name = "testing"
-  password = {
-    vault_key_id = semaphoreui_project_key.test.id
+vaults = {
+  testing = {
+    password = {
+      value = semaphoreui_project_environment.vault.secrets["VAULT_PASSWORD"].value
+    }
   }
-}]
-`),
+  production = {
+    password = {
+      file = "`+passwordFile+`"
+    }
+  }
+}
+`) + vault,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "name", fmt.Sprintf("Test %s", nameSuffix)),
@@ -649,11 +725,14 @@ vaults = [{
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.0", "--help"),
 					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "arguments.1", "--verbose"),
 
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.#", "1"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.0.name", "testing"),
-					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.0.password.%", "1"),
-					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "vaults.0.password.vault_key_id"),
-					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.0.client_script"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.testing.password.value", "secret-password"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "vaults.testing.password.vault_key_id"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.testing.password.file_hash"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "vaults.testing.client_script"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "vaults.production.password.file", passwordFile),
+					resource.TestMatchResourceAttr("semaphoreui_project_template.test", "vaults.production.password.file_hash", regexp.MustCompile(`^[0-9a-f]{32}\$[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_template.test", "vaults.production.password.vault_key_id"),
 
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "survey_vars"),
 					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "build"),
@@ -676,3 +755,292 @@ vaults = [{
 		},
 	})
 }
+
+func TestAcc_ProjectTemplateResource_schedules(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+schedules = [{
+  name        = "nightly"
+  cron_format = "0 0 * * *"
+}, {
+  name    = "release"
+  run_at  = "2099-01-31T23:00:00+01:00"
+  enabled = false
+}]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "schedules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_template.test", "schedules.*", map[string]string{
+						"name":        "nightly",
+						"cron_format": "0 0 * * *",
+						"enabled":     "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_template.test", "schedules.*", map[string]string{
+						"name":    "release",
+						"run_at":  "2099-01-31T23:00:00+01:00",
+						"enabled": "false",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "semaphoreui_project_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"schedules"},
+				ImportStateIdFunc:       testAccProjectTemplateImportID("semaphoreui_project_template.test"),
+			},
+			// Update testing
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+schedules = [{
+  name        = "hourly"
+  cron_format = "0 * * * *"
+}]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "schedules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_template.test", "schedules.*", map[string]string{
+						"name":        "hourly",
+						"cron_format": "0 * * * *",
+						"enabled":     "true",
+					}),
+				),
+			},
+			// Remove all schedules
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+schedules = []
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "schedules.#", "0"),
+				),
+			},
+			// Delete testing
+			{
+				Config: testAccProjectTemplateDependencyConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_template.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectTemplateResource_otherProjectView(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	other := fmt.Sprintf(`
+resource "semaphoreui_project" "other" {
+  name = "other-%[1]s"
+}
+
+resource "semaphoreui_project_view" "other" {
+  project_id = semaphoreui_project.other.id
+  title      = "Other View"
+  position   = 0
+}`, nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateDependencyConfig(nameSuffix) + other,
+			},
+			{
+				Config:      testAccProjectTemplateConfig(nameSuffix, "view_id = semaphoreui_project_view.other.id") + other,
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Project View"),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectTemplateResource_notBuildTemplate(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	task := fmt.Sprintf(`
+resource "semaphoreui_project_template" "task" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Task %[1]s"
+  playbook       = "playbook.yml"
+}`, nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateDependencyConfig(nameSuffix) + task,
+			},
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `deploy = {
+    build_template_id = semaphoreui_project_template.task.id
+  }`) + task,
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Build Template"),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectTemplateResource_names(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_template" "build" {
+  project_name     = semaphoreui_project.test.name
+  environment_name = semaphoreui_project_environment.test.name
+  inventory_name   = semaphoreui_project_inventory.test.name
+  repository_name  = semaphoreui_project_repository.test.name
+  view_name        = semaphoreui_project_view.test.title
+  name             = "Build %[2]s"
+  playbook         = "playbook.yml"
+  build = {
+    start_version = "1.0.0"
+  }
+}
+
+resource "semaphoreui_project_template" "test" {
+  project_name     = semaphoreui_project.test.name
+  environment_name = semaphoreui_project_environment.test.name
+  inventory_name   = semaphoreui_project_inventory.test.name
+  repository_name  = semaphoreui_project_repository.test.name
+  name             = "Test %[2]s"
+  playbook         = "playbook.yml"
+  deploy = {
+    build_template_name = semaphoreui_project_template.build.name
+  }
+}`, testAccProjectTemplateDependencyConfig(nameSuffix), nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.build", "build"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.build", "project_id", "semaphoreui_project.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.build", "environment_id", "semaphoreui_project_environment.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.build", "inventory_id", "semaphoreui_project_inventory.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.build", "repository_id", "semaphoreui_project_repository.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.build", "view_id", "semaphoreui_project_view.test", "id"),
+
+					testAccProjectTemplateExists("semaphoreui_project_template.test", "deploy"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "deploy.build_template_id", "semaphoreui_project_template.build", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "deploy.build_template_name", fmt.Sprintf("Build %s", nameSuffix)),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "view_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectTemplateResource_app(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	workspace := fmt.Sprintf(`
+resource "semaphoreui_project_inventory" "workspace" {
+  project_id = semaphoreui_project.test.id
+  name       = "Workspace-%[1]s"
+  ssh_key_id = semaphoreui_project_key.test.id
+  terraform_workspace = {
+    workspace = "default"
+  }
+}`, nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateDependencyConfig(nameSuffix) + workspace,
+			},
+			{
+				Config:      testAccProjectTemplateConfig(nameSuffix, `app = "unknown"`) + workspace,
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Template App"),
+			},
+			{
+				Config:      testAccProjectTemplateConfig(nameSuffix, `app = "terraform"`) + workspace,
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Template Inventory"),
+			},
+			{
+				Config: testAccProjectTemplateDependencyConfig(nameSuffix) + workspace + fmt.Sprintf(`
+resource "semaphoreui_project_template" "test" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.workspace.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Test %[1]s"
+  playbook       = "main"
+  app            = "terraform"
+}`, nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "app", "terraform"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "inventory_id", "semaphoreui_project_inventory.workspace", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectTemplateResource_taskParams(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectTemplateConfig(nameSuffix, `terraform = { auto_approve = true }`),
+				ExpectError: regexp.MustCompile("Invalid SemaphoreUI Template Task Parameters"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+  ansible = {
+    limit = ["web", "db"]
+    tags  = ["deploy"]
+    diff  = true
+
+    allow_override_limit = true
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.limit.#", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.limit.0", "web"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.tags.0", "deploy"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "ansible.skip_tags"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.debug", "false"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.diff", "true"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.allow_override_limit", "true"),
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "ansible.allow_override_tags", "false"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "terraform"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "shell"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_project_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectTemplateImportID("semaphoreui_project_template.test"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, `
+  app = "bash"
+  shell = {
+    interpreter_args = ["-e"]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_template.test", "shell.interpreter_args.0", "-e"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_template.test", "ansible"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectTemplateSchedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectTemplateSchedulesDataSource{}
+)
+
+func NewProjectTemplateSchedulesDataSource() datasource.DataSource {
+	return &projectTemplateSchedulesDataSource{}
+}
+
+type projectTemplateSchedulesDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectTemplateSchedulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.Client
+}
+
+// Metadata returns the data source type name.
+func (d *projectTemplateSchedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_template_schedules"
+}
+
+type projectTemplateSchedulesDataSourceModel struct {
+	ProjectID  types.Int64            `tfsdk:"project_id"`
+	TemplateID types.Int64            `tfsdk:"template_id"`
+	Timezone   types.String           `tfsdk:"timezone"`
+	Schedules  []ProjectScheduleModel `tfsdk:"schedules"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectTemplateSchedulesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	scheduleAttributes := ProjectScheduleSchema().GetDataSource(ctx).Attributes
+	scheduleAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The schedule ID.",
+		Computed:            true,
+	}
+	scheduleAttributes["project_id"] = schema.Int64Attribute{
+		MarkdownDescription: "The project ID that the schedule belongs to.",
+		Computed:            true,
+	}
+	scheduleAttributes["timezone"] = schema.StringAttribute{
+		MarkdownDescription: "The time zone used to compute `next_runs`.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the SemaphoreUI Project Schedules of a template.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "The project ID that the template belongs to.",
+				Required:            true,
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "The template ID.",
+				Required:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The time zone SemaphoreUI evaluates the schedules in, used to compute their `next_runs`. Defaults to `UTC`.",
+				Optional:            true,
+				Validators: []validator.String{
+					semaphorevalidator.Timezone(),
+				},
+			},
+			"schedules": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the schedules that execute the template.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectTemplateSchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectTemplateSchedulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := getTemplateSchedules(d.client, config.ProjectID.ValueInt64(), config.TemplateID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Template Schedules",
+			"Could not read project template schedules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := config
+	state.Schedules = make([]ProjectScheduleModel, 0, len(schedules))
+	for _, response := range schedules {
+		model := convertScheduleResponseToProjectScheduleModel(ctx, response, &ProjectScheduleModel{})
+		model.Timezone = config.Timezone
+		resp.Diagnostics.Append(setScheduleNextRuns(&model, time.Now())...)
+		state.Schedules = append(state.Schedules, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectTemplateSchedulesDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Repo"
+  url        = "git@github.com:example/test.git"
+  branch     = "main"
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+resource "semaphoreui_project_inventory" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Inventory"
+  ssh_key_id = semaphoreui_project_key.test.id
+  file = {
+    path          = "path/to/inventory"
+    repository_id = semaphoreui_project_repository.test.id
+  }
+}
+
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Environment"
+  secrets = {
+    SECRET_ONE = {
+      type  = "var"
+      value = "VALUE_ONE"
+    }
+  }
+}
+
+# Task Template
+resource "semaphoreui_project_template" "test" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Template"
+  playbook       = "playbook.yml"
+  description    = "Description"
+}
+
+resource "semaphoreui_project_schedule" "nightly" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  name        = "Nightly"
+  cron_format = "0 0 * * *"
+  enabled     = true
+}
+
+resource "semaphoreui_project_schedule" "release" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  name        = "Release"
+  run_at      = "2099-01-31T22:00:00Z"
+  enabled     = true
+}
+
+data "semaphoreui_project_template_schedules" "test" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  timezone    = "Europe/Paris"
+
+  depends_on = [
+    semaphoreui_project_schedule.nightly,
+    semaphoreui_project_schedule.release,
+  ]
+}`
+}
+
+func TestAcc_ProjectTemplateSchedulesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateSchedulesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.#", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.0.name", "Nightly"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.0.cron_format", "0 0 * * *"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.0.next_runs.#", "5"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_template_schedules.test", "schedules.0.id", "semaphoreui_project_schedule.nightly", "id"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.1.name", "Release"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.1.run_at", "2099-01-31T22:00:00Z"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_schedules.test", "schedules.1.next_runs.0", "2099-01-31T23:00:00+01:00"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_template_schedules.test", "schedules.1.id", "semaphoreui_project_schedule.release", "id"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
 	"regexp"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 )
 
 type (
@@ -28,19 +29,36 @@ type (
 		RepositoryID  types.Int64 `tfsdk:"repository_id"`
 		ViewID        types.Int64 `tfsdk:"view_id"`
 
-		Name                    types.String `tfsdk:"name"`
-		Description             types.String `tfsdk:"description"`
-		App                     types.String `tfsdk:"app"`
-		AllowOverrideArgsInTask types.Bool   `tfsdk:"allow_override_args_in_task"`
-		Arguments               types.List   `tfsdk:"arguments"`
-		GitBranch               types.String `tfsdk:"git_branch"`
-		Playbook                types.String `tfsdk:"playbook"`
-		SuppressSuccessAlerts   types.Bool   `tfsdk:"suppress_success_alerts"`
-		SurveyVars              types.List   `tfsdk:"survey_vars"`
-		Vaults                  types.List   `tfsdk:"vaults"`
+		Name                         types.String `tfsdk:"name"`
+		Description                  types.String `tfsdk:"description"`
+		App                          types.String `tfsdk:"app"`
+		AllowOverrideArgsInTask      types.Bool   `tfsdk:"allow_override_args_in_task"`
+		AllowOverrideBranchInTask    types.Bool   `tfsdk:"allow_override_branch_in_task"`
+		AllowOverrideInventoryInTask types.Bool   `tfsdk:"allow_override_inventory_in_task"`
+		Arguments                    types.List   `tfsdk:"arguments"`
+		GitBranch                    types.String `tfsdk:"git_branch"`
+		Playbook                     types.String `tfsdk:"playbook"`
+		SuppressSuccessAlerts        types.Bool   `tfsdk:"suppress_success_alerts"`
+		SurveyVars                   types.Map    `tfsdk:"survey_vars"`
+		Vaults                       types.Map    `tfsdk:"vaults"`
+
+		Schedules []ProjectTemplateScheduleModel `tfsdk:"schedules"`
 
 		Build  *ProjectTemplateTypeBuildModel  `tfsdk:"build"`
 		Deploy *ProjectTemplateTypeDeployModel `tfsdk:"deploy"`
+
+		Ansible   *ProjectTemplateAnsibleModel   `tfsdk:"ansible"`
+		Terraform *ProjectTemplateTerraformModel `tfsdk:"terraform"`
+		Shell     *ProjectTemplateShellModel     `tfsdk:"shell"`
+	}
+
+	ProjectTemplateResourceModel struct {
+		ProjectTemplateModel
+		ProjectName     types.String `tfsdk:"project_name"`
+		EnvironmentName types.String `tfsdk:"environment_name"`
+		InventoryName   types.String `tfsdk:"inventory_name"`
+		RepositoryName  types.String `tfsdk:"repository_name"`
+		ViewName        types.String `tfsdk:"view_name"`
 	}
 
 	ProjectTemplateTypeBuildModel struct {
@@ -48,56 +66,101 @@ type (
 	}
 
 	ProjectTemplateTypeDeployModel struct {
-		BuildTemplateID types.Int64 `tfsdk:"build_template_id"`
-		Autorun         types.Bool  `tfsdk:"autorun"`
+		BuildTemplateID   types.Int64  `tfsdk:"build_template_id"`
+		BuildTemplateName types.String `tfsdk:"build_template_name"`
+		Autorun           types.Bool   `tfsdk:"autorun"`
+	}
+
+	ProjectTemplateAnsibleModel struct {
+		Limit    types.List `tfsdk:"limit"`
+		Tags     types.List `tfsdk:"tags"`
+		SkipTags types.List `tfsdk:"skip_tags"`
+		Debug    types.Bool `tfsdk:"debug"`
+		Diff     types.Bool `tfsdk:"diff"`
+
+		AllowOverrideLimit types.Bool `tfsdk:"allow_override_limit"`
+		AllowOverrideTags  types.Bool `tfsdk:"allow_override_tags"`
+	}
+
+	ProjectTemplateTerraformModel struct {
+		PlanOnly    types.Bool `tfsdk:"plan_only"`
+		AutoApprove types.Bool `tfsdk:"auto_approve"`
+		Upgrade     types.Bool `tfsdk:"upgrade"`
+	}
+
+	ProjectTemplateShellModel struct {
+		InterpreterArgs types.List `tfsdk:"interpreter_args"`
 	}
 
 	ProjectTemplateSurveyVarModel struct {
-		Name        types.String      `tfsdk:"name"`
-		Title       types.String      `tfsdk:"title"`
-		Description types.String      `tfsdk:"description"`
-		Required    types.Bool        `tfsdk:"required"`
-		Type        types.String      `tfsdk:"type"`
-		EnumValues  map[string]string `tfsdk:"enum_values"`
+		Title        types.String                         `tfsdk:"title"`
+		Description  types.String                         `tfsdk:"description"`
+		Required     types.Bool                           `tfsdk:"required"`
+		Type         types.String                         `tfsdk:"type"`
+		DefaultValue types.String                         `tfsdk:"default_value"`
+		EnumValues   []ProjectTemplateSurveyVarValueModel `tfsdk:"enum_values"`
+		Position     types.Int64                          `tfsdk:"position"`
+	}
+
+	ProjectTemplateSurveyVarValueModel struct {
+		Name  types.String `tfsdk:"name"`
+		Value types.String `tfsdk:"value"`
 	}
 
 	ProjectTemplateVaultModel struct {
 		ID           types.Int64                        `tfsdk:"id"`
-		Name         types.String                       `tfsdk:"name"`
 		Password     *ProjectTemplateVaultPasswordModel `tfsdk:"password"`
 		ClientScript *ProjectTemplateVaultScriptModel   `tfsdk:"client_script"`
 	}
 
 	ProjectTemplateVaultPasswordModel struct {
-		VaultKeyID types.Int64 `tfsdk:"vault_key_id"`
+		VaultKeyID types.Int64  `tfsdk:"vault_key_id"`
+		Value      types.String `tfsdk:"value"`
+		File       types.String `tfsdk:"file"`
+		FileHash   types.String `tfsdk:"file_hash"`
 	}
 
 	ProjectTemplateVaultScriptModel struct {
 		Script types.String `tfsdk:"script"`
 	}
+
+	ProjectTemplateScheduleModel struct {
+		Name       types.String `tfsdk:"name"`
+		CronFormat types.String `tfsdk:"cron_format"`
+		RunAt      types.String `tfsdk:"run_at"`
+		Enabled    types.Bool   `tfsdk:"enabled"`
+	}
 )
 
 var (
 	ProjectTemplateSurveyVarType = types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"name":        types.StringType,
-			"title":       types.StringType,
-			"description": types.StringType,
-			"required":    types.BoolType,
-			"type":        types.StringType,
-			"enum_values": types.MapType{
-				ElemType: types.StringType,
+			"title":         types.StringType,
+			"description":   types.StringType,
+			"required":      types.BoolType,
+			"type":          types.StringType,
+			"default_value": types.StringType,
+			"enum_values": types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name":  types.StringType,
+						"value": types.StringType,
+					},
+				},
 			},
+			"position": types.Int64Type,
 		},
 	}
 
 	ProjectTemplateVaultType = types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"id":   types.Int64Type,
-			"name": types.StringType,
+			"id": types.Int64Type,
 			"password": types.ObjectType{
 				AttrTypes: map[string]attr.Type{
 					"vault_key_id": types.Int64Type,
+					"value":        types.StringType,
+					"file":         types.StringType,
+					"file_hash":    types.StringType,
 				},
 			},
 			"client_script": types.ObjectType{
@@ -143,10 +206,26 @@ func ProjectTemplateSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the template belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the template belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"environment_id": superschema.Int64Attribute{
@@ -154,34 +233,73 @@ func ProjectTemplateSchema() superschema.Schema {
 					MarkdownDescription: "The environment (variable group) ID that the template uses.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					Required: true,
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("environment_id"),
+							path.MatchRoot("environment_name"),
+						),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"environment_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the environment (variable group) that the template uses, instead of `environment_id`.",
+					Optional:            true,
+				},
+			},
 			"inventory_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The inventory ID that the template uses.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					Required: true,
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("inventory_id"),
+							path.MatchRoot("inventory_name"),
+						),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"inventory_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the inventory that the template uses, instead of `inventory_id`.",
+					Optional:            true,
+				},
+			},
 			"repository_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The repository ID that the template uses.",
 				},
 				Resource: &schemaR.Int64Attribute{
-					Required: true,
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("repository_id"),
+							path.MatchRoot("repository_name"),
+						),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"repository_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the repository that the template uses, instead of `repository_id`.",
+					Optional:            true,
+				},
+			},
 			"name": superschema.StringAttribute{
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The display name of the template.",
@@ -205,7 +323,7 @@ func ProjectTemplateSchema() superschema.Schema {
 					MarkdownDescription: "The application name.",
 				},
 				Resource: &schemaR.StringAttribute{
-					MarkdownDescription: "Must be a valid SemaphoreUI application name. Default applications include: `ansible`, `terraform`, `tofu`, `bash`, `powershell` and `python`.",
+					MarkdownDescription: "Must be an active application of the SemaphoreUI server, as listed by the `semaphoreui_apps` data source. Default applications include: `ansible`, `terraform`, `tofu`, `bash`, `powershell` and `python`. The `terraform`, `tofu` and `terragrunt` applications use a `terraform_workspace` inventory, `ansible` uses any other inventory, and only `ansible` templates use vaults.",
 					Optional:            true,
 					Computed:            true,
 					Default:             stringdefault.StaticString("ansible"),
@@ -267,6 +385,32 @@ func ProjectTemplateSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"allow_override_branch_in_task": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Allow overriding the git branch of the repository in the task.",
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				DataSource: &schemaD.BoolAttribute{
+					Computed: true,
+				},
+			},
+			"allow_override_inventory_in_task": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Allow overriding the inventory in the task.",
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				DataSource: &schemaD.BoolAttribute{
+					Computed: true,
+				},
+			},
 			"suppress_success_alerts": superschema.BoolAttribute{
 				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "Suppress success alerts.",
@@ -286,11 +430,24 @@ func ProjectTemplateSchema() superschema.Schema {
 				},
 				Resource: &schemaR.Int64Attribute{
 					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ConflictsWith(path.MatchRoot("view_name")),
+					},
 				},
 				DataSource: &schemaD.Int64Attribute{
 					Computed: true,
 				},
 			},
+			"view_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The title of the view that the template belongs to, instead of `view_id`.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("view_id")),
+					},
+				},
+			},
 			"arguments": superschema.ListAttribute{
 				Common: &schemaR.ListAttribute{
 					MarkdownDescription: "Commandline arguments passed to the application.",
@@ -351,12 +508,32 @@ func ProjectTemplateSchema() superschema.Schema {
 							MarkdownDescription: "The ID of the build template.",
 						},
 						Resource: &schemaR.Int64Attribute{
-							Required: true,
+							MarkdownDescription: "It must be a template with `build` attributes, in the same project.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("build_template_id"),
+									path.MatchRelative().AtParent().AtName("build_template_name"),
+								),
+							},
 						},
 						DataSource: &schemaD.Int64Attribute{
 							Computed: true,
 						},
 					},
+					"build_template_name": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the build template.",
+						},
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "It can be set instead of `build_template_id`.",
+							Optional:            true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
 					"autorun": superschema.BoolAttribute{
 						Common: &schemaR.BoolAttribute{
 							MarkdownDescription: "Automatically run the deploy template after the build template.",
@@ -372,28 +549,199 @@ func ProjectTemplateSchema() superschema.Schema {
 					},
 				},
 			},
-			"survey_vars": superschema.ListNestedAttribute{
-				Common: &schemaR.ListNestedAttribute{
-					MarkdownDescription: "Survey variables.",
+			"ansible": superschema.SingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The task parameters of an `ansible` template, used by the tasks of the template.",
 				},
-				Resource: &schemaR.ListNestedAttribute{
-					Optional: true,
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Can only be set when `app` is `ansible`.",
+					Optional:            true,
 				},
-				DataSource: &schemaD.ListNestedAttribute{
+				DataSource: &schemaD.SingleNestedAttribute{
 					Computed: true,
 				},
 				Attributes: map[string]superschema.Attribute{
-					"name": superschema.StringAttribute{
-						Common: &schemaR.StringAttribute{
-							MarkdownDescription: "The name of the survey variable.",
+					"limit": superschema.ListAttribute{
+						Common: &schemaR.ListAttribute{
+							MarkdownDescription: "The hosts or groups the playbook runs on, passed as `--limit`.",
+							ElementType:         types.StringType,
 						},
-						Resource: &schemaR.StringAttribute{
-							Required: true,
+						Resource: &schemaR.ListAttribute{
+							Optional: true,
 						},
-						DataSource: &schemaD.StringAttribute{
+						DataSource: &schemaD.ListAttribute{
+							Computed: true,
+						},
+					},
+					"tags": superschema.ListAttribute{
+						Common: &schemaR.ListAttribute{
+							MarkdownDescription: "Only run the tasks with these tags, passed as `--tags`.",
+							ElementType:         types.StringType,
+						},
+						Resource: &schemaR.ListAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.ListAttribute{
+							Computed: true,
+						},
+					},
+					"skip_tags": superschema.ListAttribute{
+						Common: &schemaR.ListAttribute{
+							MarkdownDescription: "Skip the tasks with these tags, passed as `--skip-tags`.",
+							ElementType:         types.StringType,
+						},
+						Resource: &schemaR.ListAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.ListAttribute{
+							Computed: true,
+						},
+					},
+					"debug": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Run the playbook with verbose output, passed as `-vvvv`.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+					"diff": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Show the changes made to files and templates, passed as `--diff`.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+					"allow_override_limit": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Prompt for the limit when running the task, allowing to override it.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
 							Computed: true,
 						},
 					},
+					"allow_override_tags": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Prompt for the tags and skipped tags when running the task, allowing to override them.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"terraform": superschema.SingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The task parameters of a `terraform`, `tofu` or `terragrunt` template, used by the tasks of the template.",
+				},
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Can only be set when `app` is `terraform`, `tofu` or `terragrunt`.",
+					Optional:            true,
+				},
+				DataSource: &schemaD.SingleNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"plan_only": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Only plan the changes, the tasks do not apply them.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+					"auto_approve": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Apply the planned changes without waiting for a confirmation in the task.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+					"upgrade": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Upgrade the modules and providers during the initialization, passed as `init -upgrade`.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"shell": superschema.SingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The task parameters of a `bash`, `powershell` or `python` template, used by the tasks of the template.",
+				},
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Can only be set when `app` is `bash`, `powershell` or `python`.",
+					Optional:            true,
+				},
+				DataSource: &schemaD.SingleNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"interpreter_args": superschema.ListAttribute{
+						Common: &schemaR.ListAttribute{
+							MarkdownDescription: "Arguments passed to the interpreter before the script, such as `-e` for `bash`.",
+							ElementType:         types.StringType,
+						},
+						Resource: &schemaR.ListAttribute{
+							Optional: true,
+						},
+						DataSource: &schemaD.ListAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"survey_vars": superschema.MapNestedAttribute{
+				Common: &schemaR.MapNestedAttribute{
+					MarkdownDescription: "Survey variables, by variable name.",
+				},
+				Resource: &schemaR.MapNestedAttribute{
+					MarkdownDescription: "They are presented in the order of their `position`, then of their names.",
+					Optional:            true,
+				},
+				DataSource: &schemaD.MapNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
 					"title": superschema.StringAttribute{
 						Common: &schemaR.StringAttribute{
 							MarkdownDescription: "The title of the survey variable.",
@@ -452,57 +800,168 @@ func ProjectTemplateSchema() superschema.Schema {
 							Computed: true,
 						},
 					},
-					"enum_values": superschema.MapAttribute{
-						Common: &schemaR.MapAttribute{
-							MarkdownDescription: "The enum name/values.",
-							ElementType:         types.StringType,
+					"default_value": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The default value of the survey variable.",
+						},
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "It must be an integer for the `integer` type, and the value of one of the `enum_values` for the `enum` type.",
+							Optional:            true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"enum_values": superschema.ListNestedAttribute{
+						Common: &schemaR.ListNestedAttribute{
+							MarkdownDescription: "The enum values, in the order they are presented.",
 						},
-						Resource: &schemaR.MapAttribute{
+						Resource: &schemaR.ListNestedAttribute{
 							Optional: true,
-							Validators: []validator.Map{
-								mapvalidator.SizeAtLeast(1),
-								mapvalidator.AlsoRequires(path.Expressions{
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.AlsoRequires(path.Expressions{
 									path.MatchRelative().AtParent().AtName("type"),
 								}...),
 							},
 						},
-						DataSource: &schemaD.MapAttribute{
+						DataSource: &schemaD.ListNestedAttribute{
 							Computed: true,
 						},
+						Attributes: map[string]superschema.Attribute{
+							"name": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The name of the enum value, as presented to the user.",
+								},
+								Resource: &schemaR.StringAttribute{
+									Required: true,
+								},
+								DataSource: &schemaD.StringAttribute{
+									Computed: true,
+								},
+							},
+							"value": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The value of the enum value.",
+								},
+								Resource: &schemaR.StringAttribute{
+									Required: true,
+								},
+								DataSource: &schemaD.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"position": superschema.Int64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The position of the survey variable in the survey.",
+						},
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "The variables without a position are presented after the others.",
+							Optional:            true,
+						},
+						DataSource: &schemaD.Int64Attribute{
+							MarkdownDescription: "Null when the variables are presented in the order of their names.",
+							Computed:            true,
+						},
 					},
 				},
 			},
-			"vaults": superschema.ListNestedAttribute{
-				Common: &schemaR.ListNestedAttribute{
-					MarkdownDescription: "Ansible Vault Passwords.",
+			"schedules": superschema.SetNestedAttribute{
+				Common: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The schedules of the template.",
 				},
-				Resource: &schemaR.ListNestedAttribute{
-					Optional: true,
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "When set, the schedules of the template are managed with the template: schedules that are not listed are removed, so do not combine it with `semaphoreui_project_schedule` resources for the same template. Schedules are not managed when it is not set.",
+					Optional:            true,
 				},
-				DataSource: &schemaD.ListNestedAttribute{
+				DataSource: &schemaD.SetNestedAttribute{
 					Computed: true,
 				},
 				Attributes: map[string]superschema.Attribute{
-					"id": superschema.Int64Attribute{
-						Common: &schemaR.Int64Attribute{
-							MarkdownDescription: "The vault ID.",
-							Computed:            true,
+					"name": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The display name of the schedule.",
 						},
-						Resource: &schemaR.Int64Attribute{
-							PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+						Resource: &schemaR.StringAttribute{
+							Required: true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
 						},
 					},
-					"name": superschema.StringAttribute{
+					"cron_format": superschema.StringAttribute{
 						Common: &schemaR.StringAttribute{
-							MarkdownDescription: "Ansible vault ID name. Must be unique.",
+							MarkdownDescription: "The cron format of the schedule.",
 						},
 						Resource: &schemaR.StringAttribute{
-							Required: true,
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("cron_format"),
+									path.MatchRelative().AtParent().AtName("run_at"),
+								),
+								semaphorevalidator.CronFormat(),
+							},
 						},
 						DataSource: &schemaD.StringAttribute{
 							Computed: true,
 						},
 					},
+					"run_at": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The time the schedule runs once, in RFC 3339 format.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("cron_format"),
+									path.MatchRelative().AtParent().AtName("run_at"),
+								),
+								semaphorevalidator.RFC3339(),
+							},
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"enabled": superschema.BoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether the schedule is enabled.",
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						DataSource: &schemaD.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vaults": superschema.MapNestedAttribute{
+				Common: &schemaR.MapNestedAttribute{
+					MarkdownDescription: "Ansible Vault Passwords, by vault ID name. The empty name is the default vault.",
+				},
+				Resource: &schemaR.MapNestedAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.MapNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"id": superschema.Int64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The vault ID.",
+							Computed:            true,
+						},
+						Resource: &schemaR.Int64Attribute{
+							PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+						},
+					},
 					"password": superschema.SingleNestedAttribute{
 						Common: &schemaR.SingleNestedAttribute{
 							MarkdownDescription: "Unlock vault using a password.",
@@ -522,12 +981,67 @@ func ProjectTemplateSchema() superschema.Schema {
 									MarkdownDescription: "The project key ID to use.",
 								},
 								Resource: &schemaR.Int64Attribute{
-									Required: true,
+									MarkdownDescription: "The key must be a `login_password` key, its `password` is the vault password. Set to the ID of the managed key when `value` or `file` is set.",
+									Optional:            true,
+									Computed:            true,
+									Validators: []validator.Int64{
+										int64validator.ExactlyOneOf(
+											path.MatchRelative().AtParent().AtName("vault_key_id"),
+											path.MatchRelative().AtParent().AtName("value"),
+											path.MatchRelative().AtParent().AtName("file"),
+										),
+									},
 								},
 								DataSource: &schemaD.Int64Attribute{
 									Computed: true,
 								},
 							},
+							"value": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The vault password, stored in a `login_password` project key managed with the template.",
+									Sensitive:           true,
+								},
+								Resource: &schemaR.StringAttribute{
+									MarkdownDescription: "For example the value of an environment secret, `semaphoreui_project_environment.example.secrets[\"VAULT_PASSWORD\"].value`, so the password is not duplicated in the configuration. The key is created, updated, and removed with the template. Note that the password is copied into a new `login_password` key rather than shared with its source: the secret is stored twice in SemaphoreUI, and the copy is only updated when the template is applied.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								DataSource: &schemaD.StringAttribute{
+									MarkdownDescription: "Always null, the password is not returned by the API.",
+									Computed:            true,
+								},
+							},
+							"file": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The path of a local file containing the vault password, stored in a `login_password` project key managed with the template.",
+								},
+								Resource: &schemaR.StringAttribute{
+									MarkdownDescription: "The file is read when the template is applied, a trailing newline is ignored. The key is created, updated, and removed with the template.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								DataSource: &schemaD.StringAttribute{
+									MarkdownDescription: "Always null, the file is only known to the configuration of the template.",
+									Computed:            true,
+								},
+							},
+							"file_hash": superschema.StringAttribute{
+								Common: &schemaR.StringAttribute{
+									MarkdownDescription: "The salted hash of the vault password read from `file`, an HMAC-SHA256 keyed with a random salt.",
+									Computed:            true,
+									Sensitive:           true,
+								},
+								Resource: &schemaR.StringAttribute{
+									MarkdownDescription: "The key is updated, and the hash is generated with a new salt, when the password of the file changes.",
+								},
+								DataSource: &schemaD.StringAttribute{
+									MarkdownDescription: "Always null, the file is only known to the configuration of the template.",
+								},
+							},
 						},
 					},
 					"client_script": superschema.SingleNestedAttribute{
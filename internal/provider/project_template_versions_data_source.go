@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectTemplateVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectTemplateVersionsDataSource{}
+)
+
+func NewProjectTemplateVersionsDataSource() datasource.DataSource {
+	return &projectTemplateVersionsDataSource{}
+}
+
+type projectTemplateVersionsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectTemplateVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.Client
+}
+
+// Metadata returns the data source type name.
+func (d *projectTemplateVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_template_versions"
+}
+
+type projectTemplateVersionsDataSourceModel struct {
+	ProjectID  types.Int64                   `tfsdk:"project_id"`
+	TemplateID types.Int64                   `tfsdk:"template_id"`
+	Versions   []projectTemplateVersionModel `tfsdk:"versions"`
+}
+
+type projectTemplateVersionModel struct {
+	TaskID  types.Int64                         `tfsdk:"task_id"`
+	Version types.String                        `tfsdk:"version"`
+	Status  types.String                        `tfsdk:"status"`
+	Created types.String                        `tfsdk:"created"`
+	Deploys []projectTemplateVersionDeployModel `tfsdk:"deploys"`
+}
+
+type projectTemplateVersionDeployModel struct {
+	TaskID     types.Int64  `tfsdk:"task_id"`
+	TemplateID types.Int64  `tfsdk:"template_id"`
+	Status     types.String `tfsdk:"status"`
+	Created    types.String `tfsdk:"created"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectTemplateVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the versions built by a SemaphoreUI Project build Template, and of their deployments, from the task history of the project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "The project ID that the template belongs to.",
+				Required:            true,
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "The build template ID.",
+				Required:            true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the versions built by the tasks of the template, the most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"task_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the build task.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The version built by the task.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the build task, such as `waiting`, `running`, `success`, `error` or `stopped`.",
+							Computed:            true,
+						},
+						"created": schema.StringAttribute{
+							MarkdownDescription: "The time the build task was created.",
+							Computed:            true,
+						},
+						"deploys": schema.ListNestedAttribute{
+							MarkdownDescription: "List of the deploy tasks of the version, the most recent first.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"task_id": schema.Int64Attribute{
+										MarkdownDescription: "The ID of the deploy task.",
+										Computed:            true,
+									},
+									"template_id": schema.Int64Attribute{
+										MarkdownDescription: "The ID of the deploy template of the task.",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "The status of the deploy task.",
+										Computed:            true,
+									},
+									"created": schema.StringAttribute{
+										MarkdownDescription: "The time the deploy task was created.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// convertTasksToProjectTemplateVersionModels returns the versions built by the tasks of the template, with the deploy
+// tasks of every version, the most recent first.
+func convertTasksToProjectTemplateVersionModels(tasks []*models.Task, templateID int64) []projectTemplateVersionModel {
+	tasks = append([]*models.Task{}, tasks...)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].ID > tasks[j].ID
+	})
+
+	deploys := map[int64][]projectTemplateVersionDeployModel{}
+	for _, task := range tasks {
+		if task.BuildTaskID != 0 {
+			deploys[task.BuildTaskID] = append(deploys[task.BuildTaskID], projectTemplateVersionDeployModel{
+				TaskID:     types.Int64Value(task.ID),
+				TemplateID: types.Int64Value(task.TemplateID),
+				Status:     types.StringValue(task.Status),
+				Created:    types.StringValue(task.Created),
+			})
+		}
+	}
+
+	versions := make([]projectTemplateVersionModel, 0)
+	for _, task := range tasks {
+		if task.TemplateID != templateID {
+			continue
+		}
+		version := projectTemplateVersionModel{
+			TaskID:  types.Int64Value(task.ID),
+			Version: types.StringValue(task.Version),
+			Status:  types.StringValue(task.Status),
+			Created: types.StringValue(task.Created),
+			Deploys: deploys[task.ID],
+		}
+		if version.Deploys == nil {
+			version.Deploys = []projectTemplateVersionDeployModel{}
+		}
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectTemplateVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectTemplateVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := d.client.Project.GetProjectProjectIDTemplatesTemplateID(&project.GetProjectProjectIDTemplatesTemplateIDParams{
+		ProjectID:  config.ProjectID.ValueInt64(),
+		TemplateID: config.TemplateID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Template",
+			"Could not read project template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if template.Payload.Type != "build" {
+		resp.Diagnostics.AddError(
+			"Invalid SemaphoreUI Build Template",
+			fmt.Sprintf("The template %d (%s) is not a build template, only build templates have versions.", template.Payload.ID, template.Payload.Name),
+		)
+		return
+	}
+
+	tasks, err := d.client.Project.GetProjectProjectIDTasks(&project.GetProjectProjectIDTasksParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Tasks",
+			"Could not read project tasks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := config
+	state.Versions = convertTasksToProjectTemplateVersionModels(tasks.Payload, config.TemplateID.ValueInt64())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
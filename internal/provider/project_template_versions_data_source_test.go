@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+func TestAcc_ProjectTemplateVersionsDataSource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateDeployConfig(nameSuffix, "") + `
+data "semaphoreui_project_template_versions" "test" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.build.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_template_versions.test", "template_id", "semaphoreui_project_template.build", "id"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_template_versions.test", "versions.#", "0"),
+				),
+			},
+			{
+				Config: testAccProjectTemplateDeployConfig(nameSuffix, "") + `
+data "semaphoreui_project_template_versions" "test" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+}`,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`The template [0-9]+ \(Test %s\) is not a build template`, nameSuffix)),
+			},
+		},
+	})
+}
+
+func TestConvertTasksToProjectTemplateVersionModels(t *testing.T) {
+	tasks := []*models.Task{
+		{ID: 1, TemplateID: 10, Version: "1.0.0", Status: "success", Created: "2024-01-01T00:00:00Z"},
+		{ID: 4, TemplateID: 20, BuildTaskID: 1, Status: "success", Created: "2024-01-04T00:00:00Z"},
+		{ID: 2, TemplateID: 30, Status: "success", Created: "2024-01-02T00:00:00Z"},
+		{ID: 6, TemplateID: 21, BuildTaskID: 3, Status: "running", Created: "2024-01-06T00:00:00Z"},
+		{ID: 3, TemplateID: 10, Version: "1.1.0", Status: "success", Created: "2024-01-03T00:00:00Z"},
+		{ID: 5, TemplateID: 20, BuildTaskID: 1, Status: "error", Created: "2024-01-05T00:00:00Z"},
+		{ID: 7, TemplateID: 10, Version: "1.2.0", Status: "waiting", Created: "2024-01-07T00:00:00Z"},
+	}
+
+	deploy := func(id int64, templateID int64, status string, created string) projectTemplateVersionDeployModel {
+		return projectTemplateVersionDeployModel{
+			TaskID:     types.Int64Value(id),
+			TemplateID: types.Int64Value(templateID),
+			Status:     types.StringValue(status),
+			Created:    types.StringValue(created),
+		}
+	}
+	expected := []projectTemplateVersionModel{
+		{
+			TaskID:  types.Int64Value(7),
+			Version: types.StringValue("1.2.0"),
+			Status:  types.StringValue("waiting"),
+			Created: types.StringValue("2024-01-07T00:00:00Z"),
+			Deploys: []projectTemplateVersionDeployModel{},
+		},
+		{
+			TaskID:  types.Int64Value(3),
+			Version: types.StringValue("1.1.0"),
+			Status:  types.StringValue("success"),
+			Created: types.StringValue("2024-01-03T00:00:00Z"),
+			Deploys: []projectTemplateVersionDeployModel{
+				deploy(6, 21, "running", "2024-01-06T00:00:00Z"),
+			},
+		},
+		{
+			TaskID:  types.Int64Value(1),
+			Version: types.StringValue("1.0.0"),
+			Status:  types.StringValue("success"),
+			Created: types.StringValue("2024-01-01T00:00:00Z"),
+			Deploys: []projectTemplateVersionDeployModel{
+				deploy(5, 20, "error", "2024-01-05T00:00:00Z"),
+				deploy(4, 20, "success", "2024-01-04T00:00:00Z"),
+			},
+		},
+	}
+
+	versions := convertTasksToProjectTemplateVersionModels(tasks, 10)
+	if !reflect.DeepEqual(versions, expected) {
+		t.Fatalf("expected the versions %v, got %v", expected, versions)
+	}
+	if tasks[0].ID != 1 || tasks[1].ID != 4 {
+		t.Fatalf("expected the tasks not to be reordered")
+	}
+
+	versions = convertTasksToProjectTemplateVersionModels(tasks, 40)
+	if versions == nil || len(versions) != 0 {
+		t.Fatalf("expected no versions for a template without tasks, got %v", versions)
+	}
+}
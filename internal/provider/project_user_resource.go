@@ -14,6 +14,7 @@ var (
 	_ resource.Resource                = &projectUserResource{}
 	_ resource.ResourceWithConfigure   = &projectUserResource{}
 	_ resource.ResourceWithImportState = &projectUserResource{}
+	_ resource.ResourceWithModifyPlan  = &projectUserResource{}
 )
 
 func NewProjectUserResource() resource.Resource {
@@ -29,16 +30,16 @@ func (r *projectUserResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,9 +70,79 @@ func (r *projectUserResource) getProjectUserModelFromAPI(projectId types.Int64,
 	return nil, fmt.Errorf("user with ID %d not found in project with ID %d", userId.ValueInt64(), projectId.ValueInt64())
 }
 
+// projectRoleRanks ranks the project roles by their permissions, the owner and manager roles manage the project.
+var projectRoleRanks = map[string]int{
+	"guest":       0,
+	"task_runner": 1,
+	"manager":     2,
+	"owner":       3,
+}
+
+// projectUserLockout returns why the plan of the project user would lock the provider out of the project when the
+// project user is the user the provider is authenticated as, or "" when it would not: destroying the project user or
+// replacing it, which removes it from the project, or demoting it from the owner or manager role.
+func projectUserLockout(plan *ProjectUserResourceModel, state *ProjectUserResourceModel) string {
+	if plan == nil {
+		return fmt.Sprintf("removing it from project ID %d", state.ProjectID.ValueInt64())
+	}
+	if !plan.ProjectID.Equal(state.ProjectID) || !plan.UserID.Equal(state.UserID) {
+		return fmt.Sprintf("replacing it, which first removes it from project ID %d,", state.ProjectID.ValueInt64())
+	}
+	if plan.Role.IsUnknown() {
+		return ""
+	}
+	stateRank, planRank := projectRoleRanks[state.Role.ValueString()], projectRoleRanks[plan.Role.ValueString()]
+	if stateRank >= projectRoleRanks["manager"] && planRank < stateRank {
+		return fmt.Sprintf("demoting it from %s to %s in project ID %d", state.Role.ValueString(), plan.Role.ValueString(), state.ProjectID.ValueInt64())
+	}
+	return ""
+}
+
+// ModifyPlan resolves the project name of the project user, and prevents plans that would remove the user the provider
+// is authenticated as from the project, or demote it from the owner or manager role.
+func (r *projectUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var state ProjectUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var plan *ProjectUserResourceModel
+	if !req.Plan.Raw.IsNull() {
+		plan = &ProjectUserResourceModel{}
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lockout := projectUserLockout(plan, &state)
+	if lockout == "" {
+		return
+	}
+
+	currentUser, err := getCurrentUser(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Current User",
+			err.Error(),
+		)
+		return
+	}
+	if currentUser.ID != state.UserID.ValueInt64() {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Project User Lockout",
+		fmt.Sprintf("The provider is authenticated as user %s (ID %d), %s would lock the provider out of the project.", currentUser.Username, currentUser.ID, lockout),
+	)
+}
+
 func (r *projectUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan ProjectUserModel
+	var plan ProjectUserResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -105,7 +176,8 @@ func (r *projectUserResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Set state to fully populated data
-	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+	plan.ProjectUserModel = *user
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -114,7 +186,7 @@ func (r *projectUserResource) Create(ctx context.Context, req resource.CreateReq
 // Read refreshes the Terraform state with the latest data.
 func (r *projectUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectUserModel
+	var state ProjectUserResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,7 +203,8 @@ func (r *projectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+	state.ProjectUserModel = *user
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -140,7 +213,8 @@ func (r *projectUserResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectUserModel
+	var plan ProjectUserResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -174,7 +248,8 @@ func (r *projectUserResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Update resource state with updated projectUser
-	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+	plan.ProjectUserModel = *user
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -182,7 +257,7 @@ func (r *projectUserResource) Update(ctx context.Context, req resource.UpdateReq
 
 func (r *projectUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectUserModel
+	var state ProjectUserResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,7 +296,7 @@ func (r *projectUserResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &user)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectUserResourceModel{ProjectUserModel: *user})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
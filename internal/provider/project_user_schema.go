@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
@@ -17,6 +21,11 @@ type ProjectUserModel struct {
 	Name      types.String `tfsdk:"name"`
 }
 
+type ProjectUserResourceModel struct {
+	ProjectUserModel
+	ProjectName types.String `tfsdk:"project_name"`
+}
+
 func ProjectUserSchema() superschema.Schema {
 	return superschema.Schema{
 		Common: superschema.SchemaDetails{
@@ -32,7 +41,26 @@ func ProjectUserSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "ID of the project.",
-					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Name of the project, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"user_id": superschema.Int64Attribute{
@@ -40,6 +68,9 @@ func ProjectUserSchema() superschema.Schema {
 					MarkdownDescription: "The ID of the user.",
 					Required:            true,
 				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
 			},
 			"role": superschema.StringAttribute{
 				Common: &schemaR.StringAttribute{
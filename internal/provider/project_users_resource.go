@@ -0,0 +1,564 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strconv"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectUsersResource{}
+	_ resource.ResourceWithConfigure   = &projectUsersResource{}
+	_ resource.ResourceWithImportState = &projectUsersResource{}
+	_ resource.ResourceWithModifyPlan  = &projectUsersResource{}
+)
+
+func NewProjectUsersResource() resource.Resource {
+	return &projectUsersResource{}
+}
+
+type projectUsersResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectUsersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *projectUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_users"
+}
+
+func (r *projectUsersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectUsersSchema().GetResource(ctx)
+}
+
+// projectUserKeys returns the users map key referencing each project member, by member ID. A key references the member
+// with that username first, so a numeric key only references a member by ID when no member has it as username.
+func projectUserKeys(keys []string, members []*models.ProjectUser) map[int64]string {
+	memberKeys := make(map[int64]string, len(members))
+	used := make(map[string]bool, len(keys))
+	for _, member := range members {
+		for _, key := range keys {
+			if key == member.Username {
+				memberKeys[member.ID] = key
+				used[key] = true
+				break
+			}
+		}
+	}
+	for _, member := range members {
+		if _, ok := memberKeys[member.ID]; ok {
+			continue
+		}
+		for _, key := range keys {
+			if !used[key] && key == strconv.FormatInt(member.ID, 10) {
+				memberKeys[member.ID] = key
+				used[key] = true
+				break
+			}
+		}
+	}
+	return memberKeys
+}
+
+// projectMemberLockout returns why giving the project member the role would lock the provider out of the project when
+// the member is the user the provider is authenticated as, or "" when it would not, like projectUserLockout.
+func projectMemberLockout(projectId types.Int64, member *models.ProjectUser, role types.String) string {
+	state := ProjectUserResourceModel{ProjectUserModel: ProjectUserModel{
+		ProjectID: projectId,
+		UserID:    types.Int64Value(member.ID),
+		Role:      types.StringValue(member.Role),
+	}}
+	plan := state
+	plan.Role = role
+	return projectUserLockout(&plan, &state)
+}
+
+func (r *projectUsersResource) getProjectMembers(projectId int64) ([]*models.ProjectUser, error) {
+	payload, err := r.client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectId}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Users for project ID %d: %s", projectId, err.Error())
+	}
+	return payload.Payload, nil
+}
+
+// resolveProjectUsers converts the users map keyed by username or user ID into a map keyed by user ID. A key that is
+// the username of a user references that user, even when it is numeric.
+func (r *projectUsersResource) resolveProjectUsers(users map[string]string) (map[int64]string, error) {
+	payload, err := r.client.User.GetUsers(&user.GetUsersParams{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Users: %s", err.Error())
+	}
+
+	resolved := make(map[int64]string, len(users))
+	for key, role := range users {
+		var id int64
+		for _, u := range payload.Payload {
+			if u.Username == key {
+				id = u.ID
+				break
+			}
+		}
+		if id == 0 {
+			id, err = strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("user with username %s not found", key)
+			}
+		}
+		if _, ok := resolved[id]; ok {
+			return nil, fmt.Errorf("user with ID %d is listed more than once", id)
+		}
+		resolved[id] = role
+	}
+	return resolved, nil
+}
+
+func convertProjectMembersToProjectUsersModel(ctx context.Context, projectId int64, members []*models.ProjectUser, prev map[string]string) (*ProjectUsersModel, error) {
+	prevKeys := make([]string, 0, len(prev))
+	for key := range prev {
+		prevKeys = append(prevKeys, key)
+	}
+	memberKeys := projectUserKeys(prevKeys, members)
+
+	users := make(map[string]string, len(members))
+	for _, member := range members {
+		// Keep the key the user was referenced by, default to the username for members not in the previous state
+		key, ok := memberKeys[member.ID]
+		if !ok {
+			key = member.Username
+		}
+		users[key] = member.Role
+	}
+
+	usersValue, diags := types.MapValueFrom(ctx, types.StringType, users)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not convert Users for project ID %d", projectId)
+	}
+	return &ProjectUsersModel{
+		ProjectID: types.Int64Value(projectId),
+		Users:     usersValue,
+	}, nil
+}
+
+func (r *projectUsersResource) getProjectUsersModelFromAPI(ctx context.Context, projectId int64, prev map[string]string) (*ProjectUsersModel, error) {
+	members, err := r.getProjectMembers(projectId)
+	if err != nil {
+		return nil, err
+	}
+	return convertProjectMembersToProjectUsersModel(ctx, projectId, members, prev)
+}
+
+// applyProjectUsers adds, updates and removes project members so that the project membership matches users.
+func (r *projectUsersResource) applyProjectUsers(projectId int64, users map[string]string) error {
+	desired, err := r.resolveProjectUsers(users)
+	if err != nil {
+		return err
+	}
+
+	hasOwner := false
+	for _, role := range desired {
+		if role == "owner" {
+			hasOwner = true
+			break
+		}
+	}
+	if !hasOwner {
+		return fmt.Errorf("project ID %d must have at least one user with the owner role", projectId)
+	}
+
+	members, err := r.getProjectMembers(projectId)
+	if err != nil {
+		return err
+	}
+	currentUser, err := getCurrentUser(r.client)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[int64]string, len(members))
+	for _, member := range members {
+		existing[member.ID] = member.Role
+		if member.ID != currentUser.ID {
+			continue
+		}
+		role, ok := desired[member.ID]
+		if !ok {
+			return fmt.Errorf("refusing to remove user %s from project ID %d, the provider is authenticated as this user", currentUser.Username, projectId)
+		}
+		lockout := projectMemberLockout(types.Int64Value(projectId), member, types.StringValue(role))
+		if lockout != "" {
+			return fmt.Errorf("the provider is authenticated as user %s, %s would lock the provider out of the project", currentUser.Username, lockout)
+		}
+	}
+
+	// Add and update members before removing any, so the project always keeps an owner
+	ids := make([]int64, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		role := desired[id]
+		current, ok := existing[id]
+		if !ok {
+			_, err := r.client.Project.PostProjectProjectIDUsers(&project.PostProjectProjectIDUsersParams{
+				ProjectID: projectId,
+				User: project.PostProjectProjectIDUsersBody{
+					Role:   role,
+					UserID: id,
+				},
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("could not add user ID %d to project ID %d: %s", id, projectId, err.Error())
+			}
+		} else if current != role {
+			_, err := r.client.Project.PutProjectProjectIDUsersUserID(&project.PutProjectProjectIDUsersUserIDParams{
+				ProjectID: projectId,
+				UserID:    id,
+				ProjectUser: project.PutProjectProjectIDUsersUserIDBody{
+					Role: role,
+				},
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("could not update user ID %d in project ID %d: %s", id, projectId, err.Error())
+			}
+		}
+	}
+
+	for _, member := range members {
+		if _, ok := desired[member.ID]; ok {
+			continue
+		}
+		_, err := r.client.Project.DeleteProjectProjectIDUsersUserID(&project.DeleteProjectProjectIDUsersUserIDParams{
+			ProjectID: projectId,
+			UserID:    member.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not remove user ID %d from project ID %d: %s", member.ID, projectId, err.Error())
+		}
+	}
+	return nil
+}
+
+func (r *projectUsersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ProjectUsersModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Users.IsUnknown() || plan.Users.IsNull() {
+		return
+	}
+
+	var users map[string]types.String
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasOwner, allKnown := false, true
+	for _, role := range users {
+		if role.IsUnknown() {
+			allKnown = false
+		} else if role.ValueString() == "owner" {
+			hasOwner = true
+		}
+	}
+	if allKnown && !hasOwner {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Missing Project Owner",
+			"At least one user must have the owner role, a project can not be left without an owner.",
+		)
+		return
+	}
+
+	// The project membership can only be checked once the project exists
+	if r.client == nil || plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	members, err := r.getProjectMembers(plan.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+	currentUser, err := getCurrentUser(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Current User",
+			err.Error(),
+		)
+		return
+	}
+
+	keys := make([]string, 0, len(users))
+	for key := range users {
+		keys = append(keys, key)
+	}
+	memberKeys := projectUserKeys(keys, members)
+	for _, member := range members {
+		if member.ID != currentUser.ID {
+			continue
+		}
+		if key, ok := memberKeys[member.ID]; ok {
+			// The user is kept in the project, but must not be demoted from the owner or manager role either
+			lockout := projectMemberLockout(plan.ProjectID, member, users[key])
+			if lockout != "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("users").AtMapKey(key),
+					"Project User Lockout",
+					fmt.Sprintf("The provider is authenticated as user %s (ID %d), %s would lock the provider out of the project.", currentUser.Username, currentUser.ID, lockout),
+				)
+			}
+			return
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Project User Lockout",
+			fmt.Sprintf("The provider is authenticated as user %s (ID %d), removing it from project ID %d would lock the provider out of the project. Add the user to the users map.", currentUser.Username, currentUser.ID, plan.ProjectID.ValueInt64()),
+		)
+	}
+}
+
+func (r *projectUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ProjectUsersModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users map[string]string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyProjectUsers(plan.ProjectID.ValueInt64(), users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Users",
+			"Could not set project users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	model, err := r.getProjectUsersModelFromAPI(ctx, plan.ProjectID.ValueInt64(), users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = plan.ProjectName
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ProjectUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users map[string]string
+	if !state.Users.IsNull() && !state.Users.IsUnknown() {
+		resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Get refreshed value from API
+	model, err := r.getProjectUsersModelFromAPI(ctx, state.ProjectID.ValueInt64(), users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = state.ProjectName
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectUsersModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users map[string]string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyProjectUsers(plan.ProjectID.ValueInt64(), users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI Project Users",
+			"Could not set project users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Fetch updated values as the project user endpoints do not return the updated project users
+	model, err := r.getProjectUsersModelFromAPI(ctx, plan.ProjectID.ValueInt64(), users)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = plan.ProjectName
+
+	// Update resource state with updated project users
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ProjectUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.getProjectMembers(state.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+	currentUser, err := getCurrentUser(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Current User",
+			err.Error(),
+		)
+		return
+	}
+
+	// The provider's own user is never removed. If it isn't an owner, the owners are kept so the project isn't left without one.
+	keepOwners := true
+	for _, member := range members {
+		if member.ID == currentUser.ID && member.Role == "owner" {
+			keepOwners = false
+		}
+	}
+
+	var retained []string
+	for _, member := range members {
+		if member.ID == currentUser.ID {
+			continue
+		}
+		if keepOwners && member.Role == "owner" {
+			retained = append(retained, member.Username)
+			continue
+		}
+		_, err := r.client.Project.DeleteProjectProjectIDUsersUserID(&project.DeleteProjectProjectIDUsersUserIDParams{
+			ProjectID: state.ProjectID.ValueInt64(),
+			UserID:    member.ID,
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing Semaphore Project User",
+				fmt.Sprintf("Could not remove user ID %d from project, unexpected error: %s", member.ID, err.Error()),
+			)
+			return
+		}
+	}
+
+	if len(retained) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Project Owners Retained",
+			fmt.Sprintf("The owners %v of project ID %d were not removed, a project can not be left without an owner.", retained, state.ProjectID.ValueInt64()),
+		)
+	}
+}
+
+func (r *projectUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(req.ID, []string{"project"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Project Users Import ID",
+			"Could not parse import ID: "+err.Error(),
+		)
+		return
+	}
+
+	model, err := r.getProjectUsersModelFromAPI(ctx, fields["project"], nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Semaphore Project Users",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"reflect"
+	"regexp"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectUsersConfig(nameSuffix string, users string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_user" "test" {
+  username = "test-%[1]s"
+  name     = "Test %[1]s"
+  email    = "test-%[1]s@example.com"
+}
+
+resource "semaphoreui_user" "other" {
+  username = "other-%[1]s"
+  name     = "Other %[1]s"
+  email    = "other-%[1]s@example.com"
+}
+
+resource "semaphoreui_project_users" "test" {
+  project_id = semaphoreui_project.test.id
+  users = {
+    %[2]s
+  }
+}`, nameSuffix, users)
+}
+
+func testAccProjectUsersImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%s", rs.Primary.Attributes["project_id"]), nil
+	}
+}
+
+func TestAcc_ProjectUsersResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectUsersConfig(nameSuffix, `
+    "admin"                                 = "owner"
+    (semaphoreui_user.test.username)        = "guest"
+    tostring(semaphoreui_user.other.id)     = "task_runner"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("semaphoreui_project_users.test", "project_id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", "users.%", "3"),
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", "users.admin", "owner"),
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", fmt.Sprintf("users.test-%s", nameSuffix), "guest"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "semaphoreui_project_users.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccProjectUsersImportID("semaphoreui_project_users.test"),
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				// Imported users are keyed by username, the configuration references one user by ID
+				ImportStateVerifyIgnore: []string{"users"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectUsersConfig(nameSuffix, `
+    "admin"                          = "owner"
+    (semaphoreui_user.test.username) = "manager"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", "users.%", "2"),
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", "users.admin", "owner"),
+					resource.TestCheckResourceAttr("semaphoreui_project_users.test", fmt.Sprintf("users.test-%s", nameSuffix), "manager"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectUsersResource_missingOwner(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectUsersConfig(nameSuffix, `
+    "admin" = "manager"`),
+				ExpectError: regexp.MustCompile("Missing Project Owner"),
+			},
+		},
+	})
+}
+
+func TestProjectUserKeys(t *testing.T) {
+	members := []*models.ProjectUser{
+		{ID: 1, Username: "admin"},
+		{ID: 2, Username: "1"},
+		{ID: 3, Username: "deploy"},
+		{ID: 4, Username: "ops"},
+	}
+	// "1" is the username of user 2, so it does not reference user 1 by ID
+	got := projectUserKeys([]string{"1", "3", "ops", "5"}, members)
+	want := map[int64]string{2: "1", 3: "3", 4: "ops"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+}
+
+func TestProjectMemberLockout(t *testing.T) {
+	owner := &models.ProjectUser{ID: 1, Username: "admin", Role: "owner"}
+	for role, locksOut := range map[string]bool{"owner": false, "manager": true, "task_runner": true, "guest": true} {
+		if got := projectMemberLockout(types.Int64Value(2), owner, types.StringValue(role)); (got != "") != locksOut {
+			t.Errorf("expected lockout %v for owner demoted to %s, got %q", locksOut, role, got)
+		}
+	}
+	guest := &models.ProjectUser{ID: 1, Username: "admin", Role: "guest"}
+	if got := projectMemberLockout(types.Int64Value(2), guest, types.StringValue("task_runner")); got != "" {
+		t.Errorf("expected no lockout for a promoted guest, got %q", got)
+	}
+	if got := projectMemberLockout(types.Int64Value(2), owner, types.StringUnknown()); got != "" {
+		t.Errorf("expected no lockout for an unknown role, got %q", got)
+	}
+}
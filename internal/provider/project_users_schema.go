@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type ProjectUsersModel struct {
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	Users       types.Map    `tfsdk:"users"`
+}
+
+func ProjectUsersSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project users",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to authoritatively manage the members of a project. Users who are members of the project but are not listed are removed, including users added through the SemaphoreUI web interface. Do not combine this resource with `semaphoreui_project_user` resources for the same project.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"project_id": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "ID of the project.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Name of the project, instead of `project_id`.",
+					Optional:            true,
+				},
+			},
+			"users": superschema.MapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "Map of user to role. Keys are either the username or the numeric user ID, values are the role of the user in the project. A key that is the username of a user always references that user, so a numeric key is only used as a user ID when no user has it as username. At least one user must be an `owner`. The user the provider is authenticated as can not be removed from the project, or demoted from the owner or manager role.",
+					ElementType:         types.StringType,
+					Required:            true,
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+						mapvalidator.ValueStringsAre(
+							stringvalidator.OneOf("owner", "manager", "task_runner", "guest"),
+						),
+					},
+				},
+			},
+		},
+	}
+}
@@ -14,6 +14,7 @@ var (
 	_ resource.Resource                = &projectViewResource{}
 	_ resource.ResourceWithConfigure   = &projectViewResource{}
 	_ resource.ResourceWithImportState = &projectViewResource{}
+	_ resource.ResourceWithModifyPlan  = &projectViewResource{}
 )
 
 func NewProjectViewResource() resource.Resource {
@@ -29,16 +30,16 @@ func (r *projectViewResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *projectViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,7 +72,8 @@ func convertViewResponseToProjectViewModel(request *models.View) ProjectViewMode
 }
 
 func (r *projectViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ProjectViewModel
+	var plan ProjectViewResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -79,7 +81,7 @@ func (r *projectViewResource) Create(ctx context.Context, req resource.CreateReq
 
 	response, err := r.client.Project.PostProjectProjectIDViews(&project.PostProjectProjectIDViewsParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		View:      convertProjectViewModelToView(plan),
+		View:      convertProjectViewModelToView(plan.ProjectViewModel),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -88,9 +90,9 @@ func (r *projectViewResource) Create(ctx context.Context, req resource.CreateReq
 		)
 		return
 	}
-	model := convertViewResponseToProjectViewModel(response.Payload)
+	plan.ProjectViewModel = convertViewResponseToProjectViewModel(response.Payload)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -99,7 +101,7 @@ func (r *projectViewResource) Create(ctx context.Context, req resource.CreateReq
 // Read refreshes the Terraform state with the latest data.
 func (r *projectViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state ProjectViewModel
+	var state ProjectViewResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -116,9 +118,9 @@ func (r *projectViewResource) Read(ctx context.Context, req resource.ReadRequest
 		)
 		return
 	}
-	model := convertViewResponseToProjectViewModel(response.Payload)
+	state.ProjectViewModel = convertViewResponseToProjectViewModel(response.Payload)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,7 +129,8 @@ func (r *projectViewResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan ProjectViewModel
+	var plan ProjectViewResourceModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -162,17 +165,22 @@ func (r *projectViewResource) Update(ctx context.Context, req resource.UpdateReq
 		)
 		return
 	}
-	model := convertViewResponseToProjectViewModel(response.Payload)
+	plan.ProjectViewModel = convertViewResponseToProjectViewModel(response.Payload)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan resolves the project name of the view.
+func (r *projectViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+}
+
 func (r *projectViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state ProjectViewModel
+	var state ProjectViewResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,9 +220,9 @@ func (r *projectViewResource) ImportState(ctx context.Context, req resource.Impo
 		)
 		return
 	}
-	model := convertViewResponseToProjectViewModel(response.Payload)
+	model := ProjectViewResourceModel{ProjectViewModel: convertViewResponseToProjectViewModel(response.Payload)}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
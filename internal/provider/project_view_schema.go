@@ -20,6 +20,11 @@ type ProjectViewModel struct {
 	Position  types.Int64  `tfsdk:"position"`
 }
 
+type ProjectViewResourceModel struct {
+	ProjectViewModel
+	ProjectName types.String `tfsdk:"project_name"`
+}
+
 func ProjectViewSchema() superschema.Schema {
 	return superschema.Schema{
 		Common: superschema.SchemaDetails{
@@ -54,10 +59,26 @@ func ProjectViewSchema() superschema.Schema {
 			"project_id": superschema.Int64Attribute{
 				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The project ID that the template belongs to.",
-					Required:            true,
 				},
 				Resource: &schemaR.Int64Attribute{
-					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Required: true,
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the project that the template belongs to, instead of `project_id`.",
+					Optional:            true,
 				},
 			},
 			"title": superschema.StringAttribute{
@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"sort"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectViewsResource{}
+	_ resource.ResourceWithConfigure      = &projectViewsResource{}
+	_ resource.ResourceWithImportState    = &projectViewsResource{}
+	_ resource.ResourceWithModifyPlan     = &projectViewsResource{}
+	_ resource.ResourceWithValidateConfig = &projectViewsResource{}
+)
+
+func NewProjectViewsResource() resource.Resource {
+	return &projectViewsResource{}
+}
+
+type projectViewsResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectViewsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *projectViewsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_views"
+}
+
+func (r *projectViewsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectViewsSchema().GetResource(ctx)
+}
+
+// ValidateConfig checks that views are renamed to listed titles, and not from listed titles.
+func (r *projectViewsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectViewsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Views.IsUnknown() || config.Views.IsNull() {
+		return
+	}
+	renames, known := projectViewsRenames(ctx, config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	var titles []types.String
+	resp.Diagnostics.Append(config.Views.ElementsAs(ctx, &titles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	listed := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		if title.IsUnknown() {
+			return
+		}
+		listed[title.ValueString()] = true
+	}
+
+	for from, to := range renames {
+		if listed[from] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renames").AtMapKey(from),
+				"Invalid View Rename",
+				fmt.Sprintf("The view %q can not be renamed, it is listed in views.", from),
+			)
+		} else if !listed[to] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renames").AtMapKey(from),
+				"Invalid View Rename",
+				fmt.Sprintf("The view %q can not be renamed to %q, the new title must be listed in views.", from, to),
+			)
+		}
+	}
+}
+
+// getProjectViews returns the views of the project in the order of their tabs.
+func (r *projectViewsResource) getProjectViews(projectId int64) ([]*models.View, error) {
+	payload, err := r.client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{ProjectID: projectId}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Views for project ID %d: %s", projectId, err.Error())
+	}
+	views := payload.Payload
+	sort.SliceStable(views, func(i, j int) bool {
+		if views[i].Position != views[j].Position {
+			return views[i].Position < views[j].Position
+		}
+		return views[i].ID < views[j].ID
+	})
+	return views, nil
+}
+
+func convertProjectViewsToProjectViewsModel(ctx context.Context, projectId int64, views []*models.View) (*ProjectViewsModel, error) {
+	titles := make([]string, 0, len(views))
+	ids := make(map[string]int64, len(views))
+	for _, view := range views {
+		titles = append(titles, view.Title)
+		ids[view.Title] = view.ID
+	}
+
+	viewsValue, diags := types.ListValueFrom(ctx, types.StringType, titles)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not convert Views for project ID %d", projectId)
+	}
+	viewIDsValue, diags := types.MapValueFrom(ctx, types.Int64Type, ids)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not convert Views for project ID %d", projectId)
+	}
+	return &ProjectViewsModel{
+		ProjectID: types.Int64Value(projectId),
+		Views:     viewsValue,
+		Renames:   types.MapNull(types.StringType),
+		ViewIDs:   viewIDsValue,
+	}, nil
+}
+
+func (r *projectViewsResource) getProjectViewsModelFromAPI(ctx context.Context, projectId int64) (*ProjectViewsModel, error) {
+	views, err := r.getProjectViews(projectId)
+	if err != nil {
+		return nil, err
+	}
+	return convertProjectViewsToProjectViewsModel(ctx, projectId, views)
+}
+
+// applyProjectViews creates, renames, reorders and removes project views so that the project views match titles.
+// Views with a listed title are kept, views with a previous title of renames are renamed to the new title, and the
+// other views are removed.
+func (r *projectViewsResource) applyProjectViews(projectId int64, titles []string, renames map[string]string) error {
+	views, err := r.getProjectViews(projectId)
+	if err != nil {
+		return err
+	}
+
+	desired := make([]*models.View, len(titles))
+	var unmatched []*models.View
+	for _, view := range views {
+		index := slices.Index(titles, view.Title)
+		if index < 0 || desired[index] != nil {
+			unmatched = append(unmatched, view)
+			continue
+		}
+		desired[index] = view
+	}
+	// Views are only renamed to titles that no view has yet
+	var removed []*models.View
+	for _, view := range unmatched {
+		index := -1
+		if title, ok := renames[view.Title]; ok {
+			index = slices.Index(titles, title)
+		}
+		if index < 0 || desired[index] != nil {
+			removed = append(removed, view)
+			continue
+		}
+		desired[index] = view
+	}
+
+	for position, title := range titles {
+		view := desired[position]
+		if view == nil {
+			_, err := r.client.Project.PostProjectProjectIDViews(&project.PostProjectProjectIDViewsParams{
+				ProjectID: projectId,
+				View: &models.ViewRequest{
+					ProjectID: projectId,
+					Title:     title,
+					Position:  int64(position),
+				},
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("could not create view %s in project ID %d: %s", title, projectId, err.Error())
+			}
+			continue
+		}
+		if view.Title == title && view.Position == int64(position) {
+			continue
+		}
+		_, err := r.client.Project.PutProjectProjectIDViewsViewID(&project.PutProjectProjectIDViewsViewIDParams{
+			ProjectID: projectId,
+			ViewID:    view.ID,
+			View: &models.View{
+				ID:        view.ID,
+				ProjectID: projectId,
+				Title:     title,
+				Position:  int64(position),
+			},
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not update view ID %d in project ID %d: %s", view.ID, projectId, err.Error())
+		}
+	}
+
+	for _, view := range removed {
+		_, err := r.client.Project.DeleteProjectProjectIDViewsViewID(&project.DeleteProjectProjectIDViewsViewIDParams{
+			ProjectID: projectId,
+			ViewID:    view.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not remove view ID %d from project ID %d: %s", view.ID, projectId, err.Error())
+		}
+	}
+	return nil
+}
+
+// projectViewsRenames returns the renames of the model, and whether they are all known.
+func projectViewsRenames(ctx context.Context, model ProjectViewsModel, diags *diag.Diagnostics) (map[string]string, bool) {
+	if model.Renames.IsNull() {
+		return nil, true
+	}
+	if model.Renames.IsUnknown() {
+		return nil, false
+	}
+	var renames map[string]types.String
+	diags.Append(model.Renames.ElementsAs(ctx, &renames, false)...)
+	if diags.HasError() {
+		return nil, false
+	}
+	result := make(map[string]string, len(renames))
+	for from, to := range renames {
+		if to.IsUnknown() {
+			return nil, false
+		}
+		result[from] = to.ValueString()
+	}
+	return result, true
+}
+
+// ModifyPlan resolves the project name, and keeps the IDs of the views that keep their title or are renamed, so
+// templates referencing them through view_ids are not planned to change.
+func (r *projectViewsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resolveProjectNameReferences(ctx, r.client, req, resp, projectNameReferenceOfProject)
+	// The view IDs are only kept when the resource is updated
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ProjectViewsModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Views.IsUnknown() || !plan.ViewIDs.IsUnknown() {
+		return
+	}
+
+	var titles []types.String
+	resp.Diagnostics.Append(plan.Views.ElementsAs(ctx, &titles, false)...)
+	var stateIDs map[string]types.Int64
+	resp.Diagnostics.Append(state.ViewIDs.ElementsAs(ctx, &stateIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	renames, known := projectViewsRenames(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+	// The previous title of each new title, for the views that are renamed
+	previous := make(map[string]string, len(renames))
+	for from, to := range renames {
+		if _, ok := stateIDs[from]; ok {
+			previous[to] = from
+		}
+	}
+
+	ids := make(map[string]attr.Value, len(titles))
+	for _, title := range titles {
+		if title.IsUnknown() {
+			return
+		}
+		if id, ok := stateIDs[title.ValueString()]; ok {
+			ids[title.ValueString()] = id
+		} else if id, ok := stateIDs[previous[title.ValueString()]]; ok {
+			ids[title.ValueString()] = id
+		} else {
+			ids[title.ValueString()] = types.Int64Unknown()
+		}
+	}
+	viewIDs, diags := types.MapValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("view_ids"), viewIDs)...)
+}
+
+func (r *projectViewsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ProjectViewsModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titles []string
+	resp.Diagnostics.Append(plan.Views.ElementsAs(ctx, &titles, false)...)
+	renames, _ := projectViewsRenames(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyProjectViews(plan.ProjectID.ValueInt64(), titles, renames)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Views",
+			"Could not set project views, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	model, err := r.getProjectViewsModelFromAPI(ctx, plan.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Views",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = plan.ProjectName
+	model.Renames = plan.Renames
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectViewsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ProjectViewsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from API
+	model, err := r.getProjectViewsModelFromAPI(ctx, state.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Views",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = state.ProjectName
+	model.Renames = state.Renames
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectViewsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectViewsModel
+	resp.Diagnostics.Append(resolvePlannedProjectNameReferences(ctx, r.client, &req.Plan, projectNameReferenceOfProject)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titles []string
+	resp.Diagnostics.Append(plan.Views.ElementsAs(ctx, &titles, false)...)
+	renames, _ := projectViewsRenames(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyProjectViews(plan.ProjectID.ValueInt64(), titles, renames)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SemaphoreUI Project Views",
+			"Could not set project views, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	model, err := r.getProjectViewsModelFromAPI(ctx, plan.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Project Views",
+			err.Error(),
+		)
+		return
+	}
+	model.ProjectName = plan.ProjectName
+	model.Renames = plan.Renames
+
+	// Update resource state with updated project views
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectViewsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ProjectViewsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyProjectViews(state.ProjectID.ValueInt64(), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing SemaphoreUI Project Views",
+			"Could not remove project views, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectViewsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(req.ID, []string{"project"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Project Views Import ID",
+			"Could not parse import ID: "+err.Error(),
+		)
+		return
+	}
+
+	model, err := r.getProjectViewsModelFromAPI(ctx, fields["project"])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Semaphore Project Views",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
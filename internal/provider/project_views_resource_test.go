@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectViewsConfig(nameSuffix string, views string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_project_views" "test" {
+  project_id = semaphoreui_project.test.id
+  views      = [%[2]s]
+}`, nameSuffix, views)
+}
+
+func testAccProjectViewsImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%s", rs.Primary.Attributes["project_id"]), nil
+	}
+}
+
+// testAccProjectViewsSameID checks that the view IDs of two titles in two states of the resource are the same.
+func testAccProjectViewsSameID(ids map[string]string, title string, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["semaphoreui_project_views.test"]
+		if !ok {
+			return fmt.Errorf("not found: semaphoreui_project_views.test")
+		}
+		id := rs.Primary.Attributes["view_ids."+title]
+		if prev, ok := ids[key]; ok && prev != id {
+			return fmt.Errorf("view %s has ID %s, expected ID %s", title, id, prev)
+		}
+		ids[key] = id
+		return nil
+	}
+}
+
+// testAccProjectViewsNewID checks that the view ID of a title is not the view ID recorded under the key.
+func testAccProjectViewsNewID(ids map[string]string, title string, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["semaphoreui_project_views.test"]
+		if !ok {
+			return fmt.Errorf("not found: semaphoreui_project_views.test")
+		}
+		id := rs.Primary.Attributes["view_ids."+title]
+		if id == ids[key] {
+			return fmt.Errorf("view %s has the ID %s of a previous view", title, id)
+		}
+		return nil
+	}
+}
+
+func testAccProjectViewsTemplateConfig(nameSuffix string, views string, renames string, view string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None-%[1]s"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Repo-%[1]s"
+  url        = "git@github.com:example/test.git"
+  branch     = "main"
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+resource "semaphoreui_project_inventory" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Inventory-%[1]s"
+  ssh_key_id = semaphoreui_project_key.test.id
+  file = {
+    path          = "path/to/inventory"
+    repository_id = semaphoreui_project_repository.test.id
+  }
+}
+
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Env-%[1]s"
+}
+
+resource "semaphoreui_project_views" "test" {
+  project_id = semaphoreui_project.test.id
+  views      = [%[2]s]
+  renames    = {%[3]s}
+}
+
+resource "semaphoreui_project_template" "test" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Test %[1]s"
+  playbook       = "playbook.yml"
+  view_id        = semaphoreui_project_views.test.view_ids["%[4]s"]
+}`, nameSuffix, views, renames, view)
+}
+
+func TestAcc_ProjectViewsResource_template(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	ids := map[string]string{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectViewsTemplateConfig(nameSuffix, `"Build", "Staging"`, "", "Staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectViewsSameID(ids, "Staging", "staging"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "view_id", "semaphoreui_project_views.test", "view_ids.Staging"),
+				),
+			},
+			// A retitled view is a new view, the templates of the previous view do not move to it
+			{
+				Config: testAccProjectViewsTemplateConfig(nameSuffix, `"Build", "Prod"`, "", "Prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "view_ids.%", "2"),
+					testAccProjectViewsNewID(ids, "Prod", "staging"),
+					testAccProjectViewsSameID(ids, "Prod", "prod"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "view_id", "semaphoreui_project_views.test", "view_ids.Prod"),
+				),
+			},
+			// A renamed view keeps its ID and templates
+			{
+				Config: testAccProjectViewsTemplateConfig(nameSuffix, `"Build", "Production"`, `"Prod" = "Production"`, "Production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "view_ids.%", "2"),
+					testAccProjectViewsSameID(ids, "Production", "prod"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_template.test", "view_id", "semaphoreui_project_views.test", "view_ids.Production"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectViewsResource_invalidRename(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%s"
+}
+
+resource "semaphoreui_project_views" "test" {
+  project_id = semaphoreui_project.test.id
+  views      = ["Build"]
+  renames    = { "Deploy" = "Release" }
+}`, nameSuffix),
+				ExpectError: regexp.MustCompile("Invalid View Rename"),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectViewsResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	ids := map[string]string{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectViewsConfig(nameSuffix, `"Build", "Deploy", "Test"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("semaphoreui_project_views.test", "project_id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.#", "3"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.0", "Build"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.1", "Deploy"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.2", "Test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "view_ids.%", "3"),
+					testAccProjectViewsSameID(ids, "Build", "build"),
+					testAccProjectViewsSameID(ids, "Test", "test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "semaphoreui_project_views.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccProjectViewsImportID("semaphoreui_project_views.test"),
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+			// Reorder, remove and add testing
+			{
+				Config: testAccProjectViewsConfig(nameSuffix, `"Build", "Staging", "Production", "Deploy"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.#", "4"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.0", "Build"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.1", "Staging"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.2", "Production"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.3", "Deploy"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "view_ids.%", "4"),
+					testAccProjectViewsSameID(ids, "Build", "build"),
+					// The Test view is removed rather than renamed to a new title
+					testAccProjectViewsNewID(ids, "Staging", "test"),
+				),
+			},
+			// Remove testing
+			{
+				Config: testAccProjectViewsConfig(nameSuffix, `"Deploy"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.#", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "views.0", "Deploy"),
+					resource.TestCheckResourceAttr("semaphoreui_project_views.test", "view_ids.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectViewsResource_duplicateTitle(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectViewsConfig(nameSuffix, `"Build", "Build"`),
+				ExpectError: regexp.MustCompile("Duplicate List Value"),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type ProjectViewsModel struct {
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	Views       types.List   `tfsdk:"views"`
+	Renames     types.Map    `tfsdk:"renames"`
+	ViewIDs     types.Map    `tfsdk:"view_ids"`
+}
+
+func ProjectViewsSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project views",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to authoritatively manage the views of a project and their order. Views that are not listed are removed, including views added through the SemaphoreUI web interface. Do not combine this resource with `semaphoreui_project_view` resources for the same project.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"project_id": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "ID of the project.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("project_id"),
+							path.MatchRoot("project_name"),
+						),
+					},
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+				},
+			},
+			"project_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Name of the project, instead of `project_id`.",
+					Optional:            true,
+				},
+			},
+			"views": superschema.ListAttribute{
+				Resource: &schemaR.ListAttribute{
+					MarkdownDescription: "The titles of the views, in the order of the view tabs. Views that keep their title keep their ID. Views whose title is no longer listed are removed, and their templates no longer belong to a view, unless they are renamed with `renames`.",
+					ElementType:         types.StringType,
+					Required:            true,
+					Validators: []validator.List{
+						listvalidator.UniqueValues(),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					},
+				},
+			},
+			"renames": superschema.MapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "Map of previous view title to new view title. A view with a previous title is renamed to the new title, which must be listed in `views`, and keeps its ID and templates. Previous titles that are not views of the project are ignored, so the entries can be kept after the rename.",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Map{
+						mapvalidator.KeysAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+						mapvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					},
+				},
+			},
+			"view_ids": superschema.MapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "Map of view title to view ID, for the `view_id` of templates.",
+					ElementType:         types.Int64Type,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,9 +38,11 @@ type SemaphoreUIProvider struct {
 
 // SemaphoreUIProviderModel describes the provider data model.
 type SemaphoreUIProviderModel struct {
-	ApiToken      types.String `tfsdk:"api_token"`
-	TlsSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
-	ApiBaseUrl    types.String `tfsdk:"api_base_url"`
+	ApiToken            types.String `tfsdk:"api_token"`
+	TlsSkipVerify       types.Bool   `tfsdk:"tls_skip_verify"`
+	ApiBaseUrl          types.String `tfsdk:"api_base_url"`
+	ScheduleMinInterval types.String `tfsdk:"schedule_min_interval"`
+	DisableRequestCache types.Bool   `tfsdk:"disable_request_cache"`
 }
 
 func (p *SemaphoreUIProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -73,6 +80,17 @@ The token will be printed in the console. This token will grant the same level o
 				MarkdownDescription: "Skip TLS verification for the SemaphoreUI API when using https. This can also be defined by the `SEMAPHOREUI_TLS_SKIP_VERIFY` environment variable.  Default: `false`.",
 				Optional:            true,
 			},
+			"schedule_min_interval": schema.StringAttribute{
+				MarkdownDescription: "Warn on plan when an enabled `semaphoreui_project_schedule` is created or changed to run more often than this interval, for example `15m`. This can also be defined by the `SEMAPHOREUI_SCHEDULE_MIN_INTERVAL` environment variable. Default: no warnings.",
+				Optional:            true,
+				Validators: []validator.String{
+					semaphorevalidator.Duration(),
+				},
+			},
+			"disable_request_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disable the cache of the SemaphoreUI list requests. The provider caches the responses of the list requests, such as the list of the keys of a project, for the duration of a Terraform run, and removes the cached responses of a project when the provider changes it, so refreshing a project with many objects does not list them again for every object. Disable it when other clients change the SemaphoreUI objects during the run. This can also be defined by the `SEMAPHOREUI_DISABLE_REQUEST_CACHE` environment variable. Default: `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -104,6 +122,15 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if config.DisableRequestCache.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disable_request_cache"),
+			"Unknown SemaphoreUI Disable Request Cache",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI Disable Request Cache. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_DISABLE_REQUEST_CACHE environment variable.",
+		)
+	}
+
 	if config.TlsSkipVerify.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("tls_skip_verify"),
@@ -122,6 +149,8 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 	apiToken := os.Getenv("SEMAPHOREUI_API_TOKEN")
 	apiBaseUrl := os.Getenv("SEMAPHOREUI_API_BASE_URL")
 	tlsSkipVerify := os.Getenv("SEMAPHOREUI_TLS_SKIP_VERIFY")
+	scheduleMinInterval := os.Getenv("SEMAPHOREUI_SCHEDULE_MIN_INTERVAL")
+	disableRequestCache := os.Getenv("SEMAPHOREUI_DISABLE_REQUEST_CACHE")
 
 	if !config.ApiBaseUrl.IsNull() {
 		apiBaseUrl = config.ApiBaseUrl.ValueString()
@@ -132,6 +161,12 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 	if !config.TlsSkipVerify.IsNull() {
 		tlsSkipVerify = strconv.FormatBool(config.TlsSkipVerify.ValueBool())
 	}
+	if !config.ScheduleMinInterval.IsNull() {
+		scheduleMinInterval = config.ScheduleMinInterval.ValueString()
+	}
+	if !config.DisableRequestCache.IsNull() {
+		disableRequestCache = strconv.FormatBool(config.DisableRequestCache.ValueBool())
+	}
 
 	// If any of the expected configurations are missing, use defaults or return
 	// errors with provider-specific guidance.
@@ -161,6 +196,24 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		tlsSkipVerify = "false" // Default
 	}
 
+	if disableRequestCache == "" {
+		disableRequestCache = "false" // Default
+	}
+
+	var minInterval time.Duration
+	if scheduleMinInterval != "" {
+		duration, err := time.ParseDuration(scheduleMinInterval)
+		if err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schedule_min_interval"),
+				"Invalid SemaphoreUI Schedule Min Interval",
+				"The schedule min interval must be a positive duration such as 15m or 1h, got "+scheduleMinInterval+". "+
+					"Set the value in the configuration or use the SEMAPHOREUI_SCHEDULE_MIN_INTERVAL environment variable.",
+			)
+		}
+		minInterval = duration
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -186,14 +239,51 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 	}
 	rt.DefaultAuthentication = httptransport.BearerToken(apiToken)
 
-	client := apiclient.New(rt, strfmt.Default)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	transport := &apiTransport{Runtime: rt}
+	if disableRequestCache != "true" {
+		transport.cache = newRequestCache()
+	}
+	data := &providerData{
+		Client:              apiclient.New(transport, strfmt.Default),
+		BaseURL:             strings.TrimSuffix(u.String(), "/"),
+		ScheduleMinInterval: minInterval,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+}
+
+// providerData is passed by the provider to the resources and data sources when they are configured.
+type providerData struct {
+	// Client is the SemaphoreUI API client.
+	Client *apiclient.SemaphoreUI
+	// BaseURL is the API base URL without trailing slash, used to build the URLs of the SemaphoreUI endpoints exposed
+	// by resources, such as the integration webhooks.
+	BaseURL string
+	// ScheduleMinInterval is the interval under which schedules are reported as too frequent, 0 when they are not
+	// reported.
+	ScheduleMinInterval time.Duration
+}
+
+// apiTransport is the transport of the SemaphoreUI API client, it caches the responses of the list requests unless
+// the cache is disabled.
+type apiTransport struct {
+	*httptransport.Runtime
+	cache *requestCache
+}
+
+// Submit submits the operation, using the request cache when it is enabled.
+func (t *apiTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if t.cache == nil {
+		return t.Runtime.Submit(op)
+	}
+	return t.cache.submit(t.Runtime, t.Runtime.Consumers[runtime.JSONMime], op)
 }
 
 func (p *SemaphoreUIProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAppResource,
 		NewProjectEnvironmentResource,
+		NewProjectIntegrationAliasResource,
 		NewProjectIntegrationExtractValueResource,
 		NewProjectIntegrationMatcherResource,
 		NewProjectIntegrationResource,
@@ -204,14 +294,19 @@ func (p *SemaphoreUIProvider) Resources(ctx context.Context) []func() resource.R
 		NewProjectScheduleResource,
 		NewProjectTemplateResource,
 		NewProjectUserResource,
+		NewProjectUsersResource,
 		NewProjectViewResource,
+		NewProjectViewsResource,
 		NewUserResource,
 	}
 }
 
 func (p *SemaphoreUIProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAppsDataSource,
+		NewCurrentUserDataSource,
 		NewExternalUserDataSource,
+		NewIntegrationWebhookSimulationDataSource,
 		NewProjectDataSource,
 		NewProjectEnvironmentDataSource,
 		NewProjectIntegrationDataSource,
@@ -223,6 +318,8 @@ func (p *SemaphoreUIProvider) DataSources(ctx context.Context) []func() datasour
 		NewProjectScheduleDataSource,
 		NewProjectsDataSource,
 		NewProjectTemplateDataSource,
+		NewProjectTemplateSchedulesDataSource,
+		NewProjectTemplateVersionsDataSource,
 		NewProjectUserDataSource,
 		NewProjectViewDataSource,
 		NewUserDataSource,
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"net/url"
 	"os"
+	"terraform-provider-semaphoreui/internal/semaphoretest"
 	"terraform-provider-semaphoreui/semaphoreui/client"
 	"testing"
 
@@ -18,6 +20,29 @@ var (
 	}
 )
 
+// TestMain runs the acceptance tests against the in-memory fake SemaphoreUI API when no SemaphoreUI server is
+// configured with SEMAPHOREUI_HOSTNAME.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || testHostname() != "" {
+		os.Exit(m.Run())
+	}
+
+	server := semaphoretest.NewServer()
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		panic(err)
+	}
+	_ = os.Setenv("SEMAPHOREUI_HOSTNAME", u.Hostname())
+	_ = os.Setenv("SEMAPHOREUI_PORT", u.Port())
+	_ = os.Setenv("SEMAPHOREUI_PROTOCOL", u.Scheme)
+	_ = os.Setenv("SEMAPHOREUI_API_TOKEN", server.API.AdminToken)
+	_ = os.Setenv("SEMAPHOREUI_API_BASE_URL", server.APIBaseURL())
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
 func mustHaveEnv(t *testing.T, name string) {
 	if os.Getenv(name) == "" {
 		t.Fatalf("%s environment variable must be set for acceptance tests", name)
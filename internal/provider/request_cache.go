@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// requestCache caches the responses of the SemaphoreUI list endpoints for the duration of a Terraform run, so the
+// resources that read a single object by listing all the objects of a project, such as project keys, do not list them
+// again for every object. The raw responses are cached and read again for every request, so the callers never share
+// the returned objects.
+//
+// A write request to a project removes the cached responses of the project and the cached responses that do not belong
+// to a project, such as the users. A write request that does not belong to a project removes all the cached responses.
+type requestCache struct {
+	mu        sync.Mutex
+	responses map[string]*cachedResponse
+}
+
+// cachedResponse is a cached response of a list endpoint.
+type cachedResponse struct {
+	projectID   string
+	code        int
+	message     string
+	contentType string
+	body        []byte
+}
+
+func newRequestCache() *requestCache {
+	return &requestCache{responses: map[string]*cachedResponse{}}
+}
+
+// requestCacheVolatilePaths are the list endpoints that are not cached, their responses change without requests from
+// the provider.
+var requestCacheVolatilePaths = []string{"/tasks", "/events", "/backup"}
+
+// isCacheableOperation returns whether the responses of the operation are cached, only the responses of the list
+// endpoints are.
+func isCacheableOperation(op *runtime.ClientOperation) bool {
+	if op.Method != http.MethodGet || strings.HasSuffix(op.PathPattern, "}") {
+		return false
+	}
+	for _, volatile := range requestCacheVolatilePaths {
+		if strings.Contains(op.PathPattern, volatile) {
+			return false
+		}
+	}
+	return true
+}
+
+// submit submits the operation with the transport, using the cached response of list endpoints when there is one.
+func (c *requestCache) submit(transport runtime.ClientTransport, consumer runtime.Consumer, op *runtime.ClientOperation) (interface{}, error) {
+	request := &recordedRequest{pathParams: map[string]string{}, queryParams: url.Values{}}
+	if op.Params != nil {
+		if err := op.Params.WriteToRequest(request, strfmt.Default); err != nil {
+			return nil, err
+		}
+	}
+	projectID := request.pathParams["project_id"]
+
+	if op.Method != http.MethodGet {
+		c.invalidate(projectID)
+		return transport.Submit(op)
+	}
+	if !isCacheableOperation(op) {
+		return transport.Submit(op)
+	}
+
+	key := request.key(op.PathPattern)
+	c.mu.Lock()
+	cached, ok := c.responses[key]
+	c.mu.Unlock()
+	if ok {
+		return op.Reader.ReadResponse(cached.response(), consumer)
+	}
+
+	caching := *op
+	caching.Reader = runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+		if response.Code() != http.StatusOK {
+			return op.Reader.ReadResponse(response, consumer)
+		}
+		body, err := io.ReadAll(response.Body())
+		if err != nil {
+			return nil, err
+		}
+		cached := &cachedResponse{
+			projectID:   projectID,
+			code:        response.Code(),
+			message:     response.Message(),
+			contentType: response.GetHeader("Content-Type"),
+			body:        body,
+		}
+		result, err := op.Reader.ReadResponse(cached.response(), consumer)
+		if err == nil {
+			c.mu.Lock()
+			c.responses[key] = cached
+			c.mu.Unlock()
+		}
+		return result, err
+	})
+	return transport.Submit(&caching)
+}
+
+// invalidate removes the cached responses of the project and the cached responses that do not belong to a project, or
+// all the cached responses when projectID is empty.
+func (c *requestCache) invalidate(projectID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, cached := range c.responses {
+		if projectID == "" || cached.projectID == "" || cached.projectID == projectID {
+			delete(c.responses, key)
+		}
+	}
+}
+
+func (r *cachedResponse) response() runtime.ClientResponse {
+	return &cachedClientResponse{cachedResponse: r}
+}
+
+// cachedClientResponse replays a cached response.
+type cachedClientResponse struct {
+	*cachedResponse
+}
+
+func (r *cachedClientResponse) Code() int {
+	return r.code
+}
+
+func (r *cachedClientResponse) Message() string {
+	return r.message
+}
+
+func (r *cachedClientResponse) GetHeader(name string) string {
+	if http.CanonicalHeaderKey(name) == "Content-Type" {
+		return r.contentType
+	}
+	return ""
+}
+
+func (r *cachedClientResponse) GetHeaders(name string) []string {
+	if header := r.GetHeader(name); header != "" {
+		return []string{header}
+	}
+	return nil
+}
+
+func (r *cachedClientResponse) Body() io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(r.body))
+}
+
+var _ runtime.ClientRequest = &recordedRequest{}
+
+// recordedRequest records the parameters of an operation to build the cache key of its response.
+type recordedRequest struct {
+	pathParams  map[string]string
+	queryParams url.Values
+	header      http.Header
+	body        interface{}
+}
+
+// key returns the cache key of the request, the path of the request with its query.
+func (r *recordedRequest) key(pathPattern string) string {
+	path := pathPattern
+	names := make([]string, 0, len(r.pathParams))
+	for name := range r.pathParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path = strings.ReplaceAll(path, "{"+name+"}", url.PathEscape(r.pathParams[name]))
+	}
+	if len(r.queryParams) > 0 {
+		path += "?" + r.queryParams.Encode()
+	}
+	return path
+}
+
+func (r *recordedRequest) SetHeaderParam(name string, values ...string) error {
+	if r.header == nil {
+		r.header = http.Header{}
+	}
+	r.header[http.CanonicalHeaderKey(name)] = values
+	return nil
+}
+
+func (r *recordedRequest) GetHeaderParams() http.Header {
+	return r.header
+}
+
+func (r *recordedRequest) SetQueryParam(name string, values ...string) error {
+	r.queryParams[name] = values
+	return nil
+}
+
+func (r *recordedRequest) SetFormParam(string, ...string) error {
+	return nil
+}
+
+func (r *recordedRequest) SetPathParam(name string, value string) error {
+	r.pathParams[name] = value
+	return nil
+}
+
+func (r *recordedRequest) GetQueryParams() url.Values {
+	return r.queryParams
+}
+
+func (r *recordedRequest) SetFileParam(string, ...runtime.NamedReadCloser) error {
+	return nil
+}
+
+func (r *recordedRequest) SetBodyParam(body interface{}) error {
+	r.body = body
+	return nil
+}
+
+func (r *recordedRequest) SetTimeout(time.Duration) error {
+	return nil
+}
+
+func (r *recordedRequest) GetMethod() string {
+	return ""
+}
+
+func (r *recordedRequest) GetPath() string {
+	return ""
+}
+
+func (r *recordedRequest) GetBody() []byte {
+	return nil
+}
+
+func (r *recordedRequest) GetBodyParam() interface{} {
+	return r.body
+}
+
+func (r *recordedRequest) GetFileParam() map[string][]runtime.NamedReadCloser {
+	return nil
+}
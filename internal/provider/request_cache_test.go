@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"terraform-provider-semaphoreui/internal/semaphoretest"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	projectops "terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/projects"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// countingHandler counts the GET requests served by the fake SemaphoreUI API, by path.
+type countingHandler struct {
+	mu     sync.Mutex
+	api    http.Handler
+	counts map[string]int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		h.mu.Lock()
+		h.counts[strings.TrimPrefix(r.URL.Path, "/api")]++
+		h.mu.Unlock()
+	}
+	h.api.ServeHTTP(w, r)
+}
+
+func (h *countingHandler) count(path string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.counts[path]
+}
+
+func testRequestCacheClient(t *testing.T, cache *requestCache) (*apiclient.SemaphoreUI, *countingHandler, int64) {
+	api := semaphoretest.NewAPI()
+	handler := &countingHandler{api: api, counts: map[string]int{}}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := httptransport.New(u.Host, "/api", []string{u.Scheme})
+	rt.DefaultAuthentication = httptransport.BearerToken(api.AdminToken)
+	client := apiclient.New(&apiTransport{Runtime: rt, cache: cache}, strfmt.Default)
+
+	project, err := client.Projects.PostProjects(&projects.PostProjectsParams{
+		Project: &models.ProjectRequest{Name: "Test Project"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, handler, project.Payload.ID
+}
+
+func testRequestCacheListKeys(t *testing.T, client *apiclient.SemaphoreUI, projectID int64) []*models.AccessKey {
+	response, err := client.Project.GetProjectProjectIDKeys(&projectops.GetProjectProjectIDKeysParams{
+		ProjectID: projectID,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.Payload
+}
+
+func testRequestCacheCreateKey(t *testing.T, client *apiclient.SemaphoreUI, projectID int64, name string) {
+	_, err := client.Project.PostProjectProjectIDKeys(&projectops.PostProjectProjectIDKeysParams{
+		ProjectID: projectID,
+		AccessKey: &models.AccessKeyRequest{
+			Name:      name,
+			ProjectID: projectID,
+			Type:      "none",
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequestCache(t *testing.T) {
+	client, handler, projectID := testRequestCacheClient(t, newRequestCache())
+	path := "/project/" + strconv.FormatInt(projectID, 10) + "/keys"
+
+	testRequestCacheCreateKey(t, client, projectID, "first")
+	first := testRequestCacheListKeys(t, client, projectID)
+	second := testRequestCacheListKeys(t, client, projectID)
+	if handler.count(path) != 1 {
+		t.Fatalf("expected the keys to be listed once, got %d requests", handler.count(path))
+	}
+	if len(first) != len(second) || first[0] == second[0] {
+		t.Fatalf("expected the cached responses to be read again for every request")
+	}
+
+	testRequestCacheCreateKey(t, client, projectID, "second")
+	keys := testRequestCacheListKeys(t, client, projectID)
+	if handler.count(path) != 2 {
+		t.Fatalf("expected the keys to be listed again after a write, got %d requests", handler.count(path))
+	}
+	if len(keys) != len(first)+1 {
+		t.Fatalf("expected %d keys after a write, got %d", len(first)+1, len(keys))
+	}
+}
+
+func TestRequestCache_disabled(t *testing.T) {
+	client, handler, projectID := testRequestCacheClient(t, nil)
+	path := "/project/" + strconv.FormatInt(projectID, 10) + "/keys"
+
+	testRequestCacheListKeys(t, client, projectID)
+	testRequestCacheListKeys(t, client, projectID)
+	if handler.count(path) != 2 {
+		t.Fatalf("expected the keys to be listed twice, got %d requests", handler.count(path))
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeRawState returns a state upgrader that applies the upgrades, in order, to the attributes of the raw prior
+// state. It is used when the upgrades only move values around, so the prior schema is not needed.
+func upgradeRawState(upgrades ...func(state map[string]any) error) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		var state map[string]any
+		decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+		// Keep the numbers as they are, IDs do not always fit in a float64
+		decoder.UseNumber()
+		if err := decoder.Decode(&state); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Upgrading SemaphoreUI Resource State",
+				"Could not parse the prior resource state, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, upgrade := range upgrades {
+			if err := upgrade(state); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Upgrading SemaphoreUI Resource State",
+					"Could not upgrade the prior resource state: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		raw, err := json.Marshal(state)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Upgrading SemaphoreUI Resource State",
+				"Could not write the upgraded resource state, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		resp.DynamicValue = &tfprotov6.DynamicValue{JSON: raw}
+	}
+}
+
+// convertStateListToMap converts a list of objects of a raw state into a map of the objects by their key attribute,
+// which is removed from the objects. Anything but a list is returned as it is. Objects with the same key are an error,
+// as only one of them could be kept.
+func convertStateListToMap(value any, key string) (any, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return value, nil
+	}
+	objects := map[string]any{}
+	for _, element := range list {
+		object, ok := element.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := object[key].(string)
+		if _, ok := objects[name]; ok {
+			return nil, fmt.Errorf("several elements have the %s %q", key, name)
+		}
+		delete(object, key)
+		objects[name] = object
+	}
+	return objects, nil
+}
@@ -2,14 +2,17 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math/big"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/user"
 	"terraform-provider-semaphoreui/semaphoreui/models"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -17,6 +20,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -32,16 +36,16 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,17 +93,240 @@ func convertUserModelToUserPutRequest(user UserModel) *models.UserPutRequest {
 	}
 }
 
+const (
+	passwordLowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumericChars = "0123456789"
+	passwordSpecialChars = "!@#$%&*()-_=+[]{}<>:?"
+)
+
+func (policy *UserPasswordPolicyModel) charsets() []string {
+	var charsets []string
+	if policy.Lower.ValueBool() {
+		charsets = append(charsets, passwordLowerChars)
+	}
+	if policy.Upper.ValueBool() {
+		charsets = append(charsets, passwordUpperChars)
+	}
+	if policy.Numeric.ValueBool() {
+		charsets = append(charsets, passwordNumericChars)
+	}
+	if policy.Special.ValueBool() {
+		charsets = append(charsets, passwordSpecialChars)
+	}
+	return charsets
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n.Int64()], nil
+}
+
+// generatePassword returns a random password containing at least one character of every enabled character class.
+func generatePassword(policy *UserPasswordPolicyModel) (string, error) {
+	charsets := policy.charsets()
+	length := int(policy.Length.ValueInt64())
+	if len(charsets) == 0 {
+		return "", fmt.Errorf("password policy must enable at least one character class")
+	}
+	if length < len(charsets) {
+		return "", fmt.Errorf("password length %d is too short for %d character classes", length, len(charsets))
+	}
+
+	password := make([]byte, 0, length)
+	all := ""
+	for _, chars := range charsets {
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+		all += chars
+	}
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Shuffle so the guaranteed characters are not always at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+// passwordRotationDue returns true when the rotate_after period has passed since the password was last rotated.
+func (model *UserResourceModel) passwordRotationDue(now time.Time) bool {
+	if model.RotateAfter.IsNull() || model.RotateAfter.IsUnknown() || model.PasswordRotatedAt.IsNull() || model.PasswordRotatedAt.IsUnknown() {
+		return false
+	}
+	rotateAfter, err := time.ParseDuration(model.RotateAfter.ValueString())
+	if err != nil {
+		return false
+	}
+	rotatedAt, err := time.Parse(time.RFC3339, model.PasswordRotatedAt.ValueString())
+	if err != nil {
+		// An unparsable timestamp can't be trusted, so rotate
+		return true
+	}
+	return !now.Before(rotatedAt.Add(rotateAfter))
+}
+
+// ModifyPlan prevents plans that would lock the provider out and plans password rotations.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *UserResourceModel
+	if !req.Plan.Raw.IsNull() {
+		plan = &UserResourceModel{}
+		resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.PasswordPolicy != nil && !plan.PasswordPolicy.Lower.IsUnknown() && !plan.PasswordPolicy.Upper.IsUnknown() &&
+			!plan.PasswordPolicy.Numeric.IsUnknown() && !plan.PasswordPolicy.Special.IsUnknown() && len(plan.PasswordPolicy.charsets()) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password_policy"),
+				"Invalid Password Policy",
+				"At least one of lower, upper, numeric or special must be enabled.",
+			)
+			return
+		}
+	}
+
+	// Nothing can be locked out or rotated when the user is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkCurrentUserLockout(state, plan, resp)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	if plan.PasswordPolicy == nil && !state.GeneratedPassword.IsNull() {
+		// The generated password is no longer managed once the policy is removed
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_password"), types.StringNull())...)
+	}
+
+	rotate := !plan.PasswordVersion.Equal(state.PasswordVersion) || state.passwordRotationDue(time.Now())
+	if plan.PasswordPolicy != nil {
+		if state.PasswordPolicy == nil || *plan.PasswordPolicy != *state.PasswordPolicy {
+			rotate = true
+		}
+	} else if !plan.Password.Equal(state.Password) {
+		rotate = true
+	} else if plan.Password.IsNull() {
+		// There is no password to set again
+		rotate = false
+	}
+	if !rotate {
+		return
+	}
+
+	if plan.PasswordPolicy != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_password"), types.StringUnknown())...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_password"), types.StringNull())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_rotated_at"), types.StringUnknown())...)
+}
+
+// checkCurrentUserLockout prevents plans that would delete or remove admin rights from the user the provider is authenticated as.
+func (r *userResource) checkCurrentUserLockout(state UserResourceModel, plan *UserResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	// Only deleting the user, replacing it or removing admin rights can lock the provider out
+	replace := resp.RequiresReplace.Contains(path.Root("external"))
+	if plan != nil && !replace {
+		removesAdmin := state.Admin.ValueBool() && !plan.Admin.IsUnknown() && !plan.Admin.ValueBool()
+		if !removesAdmin {
+			return
+		}
+	}
+
+	currentUser, err := getCurrentUser(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Semaphore Current User",
+			err.Error(),
+		)
+		return
+	}
+	if currentUser.ID != state.ID.ValueInt64() {
+		return
+	}
+
+	if plan == nil || replace {
+		resp.Diagnostics.AddError(
+			"User Lockout",
+			fmt.Sprintf("The provider is authenticated as user %s (ID %d), deleting it would lock the provider out of SemaphoreUI.", currentUser.Username, currentUser.ID),
+		)
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("admin"),
+		"User Lockout",
+		fmt.Sprintf("The provider is authenticated as user %s (ID %d), removing its admin rights would lock the provider out of managing SemaphoreUI.", currentUser.Username, currentUser.ID),
+	)
+}
+
+// setPlannedPassword resolves the password to send to the API, generating one from the password policy when needed.
+func setPlannedPassword(plan *UserResourceModel, rotate bool) (string, error) {
+	if plan.PasswordPolicy == nil {
+		plan.GeneratedPassword = types.StringNull()
+		if plan.Password.IsNull() || plan.Password.IsUnknown() {
+			return "", nil
+		}
+		return plan.Password.ValueString(), nil
+	}
+	if !rotate {
+		return plan.GeneratedPassword.ValueString(), nil
+	}
+	password, err := generatePassword(plan.PasswordPolicy)
+	if err != nil {
+		return "", err
+	}
+	plan.GeneratedPassword = types.StringValue(password)
+	return password, nil
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan UserModel
+	var plan UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	password, err := setPlannedPassword(&plan, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating SemaphoreUI User Password",
+			"Could not generate user password, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Generate API request body from plan
-	var payload = convertUserModelToUserRequest(plan)
+	var payload = convertUserModelToUserRequest(plan.UserModel)
+	payload.Password = strfmt.Password(password)
 
 	//Create new user
 	response, err := r.client.User.PostUsers(&user.PostUsersParams{User: payload}, nil)
@@ -112,7 +339,12 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan = convertResponsePayloadToUserModel(response.Payload, plan)
+	plan.UserModel = convertResponsePayloadToUserModel(response.Payload, plan.UserModel)
+	if password != "" {
+		plan.PasswordRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	} else {
+		plan.PasswordRotatedAt = types.StringNull()
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, &plan)
@@ -125,7 +357,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state UserModel
+	var state UserResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -143,7 +375,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Overwrite with refreshed state
-	state = convertResponsePayloadToUserModel(response.Payload, state)
+	state.UserModel = convertResponsePayloadToUserModel(response.Payload, state.UserModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -156,7 +388,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan UserModel
+	var plan UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -164,7 +396,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Generate API request body from plan
-	var payload = convertUserModelToUserPutRequest(plan)
+	var payload = convertUserModelToUserPutRequest(plan.UserModel)
 
 	// Update existing resource
 	_, err := r.client.User.PutUsersUserID(&user.PutUsersUserIDParams{UserID: plan.ID.ValueInt64(), User: payload}, nil)
@@ -176,17 +408,31 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Update password if it's changed
-	var prevPassword types.String
+	// Update password if it's changed or a rotation is planned
+	var prevPassword, prevRotatedAt types.String
 	req.State.GetAttribute(ctx, path.Root("password"), &prevPassword)
-	if plan.Password != prevPassword {
-		_, err := r.client.User.PostUsersUserIDPassword(&user.PostUsersUserIDPasswordParams{UserID: plan.ID.ValueInt64(), Password: user.PostUsersUserIDPasswordBody{Password: strfmt.Password(plan.Password.ValueString())}}, nil)
+	req.State.GetAttribute(ctx, path.Root("password_rotated_at"), &prevRotatedAt)
+	rotate := plan.PasswordRotatedAt.IsUnknown()
+	password, err := setPlannedPassword(&plan, rotate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating SemaphoreUI User Password",
+			"Could not generate user password, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if rotate || plan.Password != prevPassword {
+		_, err := r.client.User.PostUsersUserIDPassword(&user.PostUsersUserIDPasswordParams{UserID: plan.ID.ValueInt64(), Password: user.PostUsersUserIDPasswordBody{Password: strfmt.Password(password)}}, nil)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Semaphore User Password",
 				"Could not update user password, unexpected error: "+err.Error(),
 			)
+			return
 		}
+		plan.PasswordRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	} else {
+		plan.PasswordRotatedAt = prevRotatedAt
 	}
 
 	// Fetch updated values as PutUsersUserIDParams does not return updated user
@@ -200,7 +446,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Update resource state with updated user
-	plan = convertResponsePayloadToUserModel(response.Payload, plan)
+	plan.UserModel = convertResponsePayloadToUserModel(response.Payload, plan.UserModel)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -211,7 +457,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state UserModel
+	var state UserResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -138,3 +138,105 @@ func TestAcc_UserResource_errorOnExists(t *testing.T) {
 		},
 	})
 }
+
+func testAccUserConfig_CurrentUser(admin bool) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_user" "admin" {
+  username = "admin"
+  name     = "admin"
+  email    = "admin@localhost"
+  admin    = %[1]t
+}`, admin)
+}
+
+func TestAcc_UserResource_currentUserLockout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import the user the provider is authenticated as
+			{
+				Config:             testAccUserConfig_CurrentUser(true),
+				ResourceName:       "semaphoreui_user.admin",
+				ImportState:        true,
+				ImportStateId:      "user/1",
+				ImportStatePersist: true,
+			},
+			// Removing admin rights is refused
+			{
+				Config:      testAccUserConfig_CurrentUser(false),
+				ExpectError: regexp.MustCompile("User Lockout"),
+			},
+			// Deleting the user is refused
+			{
+				Config:      `# semaphoreui_user.admin removed from configuration`,
+				ExpectError: regexp.MustCompile("User Lockout"),
+			},
+			// Forget the user without deleting it
+			{
+				Config: `
+removed {
+  from = semaphoreui_user.admin
+  lifecycle {
+    destroy = false
+  }
+}`,
+			},
+		},
+	})
+}
+
+func TestAcc_UserResource_generatedPassword(t *testing.T) {
+	userNameSuffix := acctest.RandString(8)
+	var generatedPassword string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a generated password
+			{
+				Config: testAccUserConfig(userNameSuffix, `password_version = "1"
+  password_policy = {
+    length  = 24
+    special = false
+  }
+  rotate_after = "720h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserExists("semaphoreui_user.test"),
+					resource.TestCheckNoResourceAttr("semaphoreui_user.test", "password"),
+					resource.TestCheckResourceAttrSet("semaphoreui_user.test", "password_rotated_at"),
+					resource.TestCheckResourceAttrWith("semaphoreui_user.test", "generated_password", func(value string) error {
+						if !regexp.MustCompile(`^[a-zA-Z0-9]{24}$`).MatchString(value) {
+							return fmt.Errorf("generated password does not match the policy: %s", value)
+						}
+						generatedPassword = value
+						return nil
+					}),
+				),
+			},
+			// Changing the password version rotates the password
+			{
+				Config: testAccUserConfig(userNameSuffix, `password_version = "2"
+  password_policy = {
+    length  = 24
+    special = false
+  }
+  rotate_after = "720h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("semaphoreui_user.test", "generated_password", func(value string) error {
+						if value == generatedPassword {
+							return fmt.Errorf("generated password was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// Password policy and password can't both be set
+			{
+				Config: testAccUserConfig(userNameSuffix, `password = "password!"
+  password_policy = {}`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
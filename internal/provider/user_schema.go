@@ -2,18 +2,21 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
 )
 
 type UserModel struct {
@@ -28,6 +31,25 @@ type UserModel struct {
 	Password types.String `tfsdk:"password"`
 }
 
+type (
+	UserResourceModel struct {
+		UserModel
+		PasswordVersion   types.String             `tfsdk:"password_version"`
+		PasswordPolicy    *UserPasswordPolicyModel `tfsdk:"password_policy"`
+		GeneratedPassword types.String             `tfsdk:"generated_password"`
+		RotateAfter       types.String             `tfsdk:"rotate_after"`
+		PasswordRotatedAt types.String             `tfsdk:"password_rotated_at"`
+	}
+
+	UserPasswordPolicyModel struct {
+		Length  types.Int64 `tfsdk:"length"`
+		Lower   types.Bool  `tfsdk:"lower"`
+		Upper   types.Bool  `tfsdk:"upper"`
+		Numeric types.Bool  `tfsdk:"numeric"`
+		Special types.Bool  `tfsdk:"special"`
+	}
+)
+
 func userSchema() superschema.Schema {
 	return superschema.Schema{
 		Common: superschema.SchemaDetails{
@@ -133,12 +155,104 @@ func userSchema() superschema.Schema {
 					PlanModifiers: []planmodifier.String{
 						stringplanmodifier.UseStateForUnknown(),
 					},
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("password_policy")),
+					},
 				},
 				DataSource: &schemaD.StringAttribute{
 					MarkdownDescription: "This value is never returned by the API and will be an empty string.",
 					Computed:            true,
 				},
 			},
+			"password_version": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "An arbitrary value, such as a date or counter. Changing it sets the password again, which rotates a generated password or restores a configured `password` that was changed outside of Terraform. The provider cannot detect such changes itself, because the API neither returns the password nor when it was last changed, so change this value to restore the password.",
+					Optional:            true,
+				},
+			},
+			"password_policy": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Generate a random password instead of configuring `password`. The generated password is available in `generated_password`. At least one character class must be enabled.",
+					Optional:            true,
+					Validators: []validator.Object{
+						objectvalidator.ConflictsWith(path.MatchRoot("password")),
+					},
+				},
+				Attributes: map[string]superschema.Attribute{
+					"length": superschema.Int64Attribute{
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "The length of the generated password.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(32),
+							Validators: []validator.Int64{
+								int64validator.Between(8, 256),
+							},
+						},
+					},
+					"lower": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Include lowercase letters.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+					"upper": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Include uppercase letters.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+					"numeric": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Include numbers.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+					"special": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Include special characters.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"generated_password": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The password generated from `password_policy`.",
+					Computed:            true,
+					Sensitive:           true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"rotate_after": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Generate a new password once this period has passed since the last rotation. The rotation happens on the first apply after the period has passed.",
+					Optional:            true,
+					Validators: []validator.String{
+						semaphorevalidator.Duration(),
+						stringvalidator.AlsoRequires(path.MatchRoot("password_policy")),
+					},
+				},
+			},
+			"password_rotated_at": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The time the password was last set by Terraform, in RFC3339 format.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
 			"admin": superschema.BoolAttribute{
 				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "Indicates if the user is an admin.",
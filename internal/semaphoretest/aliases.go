@@ -0,0 +1,101 @@
+package semaphoretest
+
+import (
+	"crypto/rand"
+	"net/http"
+	"strings"
+)
+
+// collectionIntegrationAliases are the aliases of the integration webhook endpoints. Aliases without an
+// integration_id belong to the project, and route the webhooks to its searchable integrations.
+var collectionIntegrationAliases = &collection{
+	name:  "integration_aliases",
+	title: "Integration Alias",
+	references: []reference{
+		{field: "integration_id", collection: "integrations", onDelete: cascade},
+	},
+	noRoutes: true,
+}
+
+// aliasLength is the length of the generated aliases.
+const aliasLength = 16
+
+func (a *API) aliasRoutes() {
+	a.handle("GET", "/project/{project_id}/integrations/aliases", accessProjectManager, a.getAliases)
+	a.handle("POST", "/project/{project_id}/integrations/aliases", accessProjectManager, a.createAlias)
+	a.handle("DELETE", "/project/{project_id}/integrations/aliases/{object_id}", accessProjectManager, a.deleteAlias)
+	a.handle("GET", "/project/{project_id}/integrations/{integration_id}/aliases", accessProjectManager, a.getAliases)
+	a.handle("POST", "/project/{project_id}/integrations/{integration_id}/aliases", accessProjectManager, a.createAlias)
+	a.handle("DELETE", "/project/{project_id}/integrations/{integration_id}/aliases/{object_id}", accessProjectManager, a.deleteAlias)
+}
+
+// aliasIntegration returns the integration of the integration_id path parameter, or 0 for the project aliases.
+func (a *API) aliasIntegration(c *call) (int64, error) {
+	if c.r.PathValue("integration_id") == "" {
+		return 0, nil
+	}
+	integrationID, err := c.PathID("integration_id")
+	if err != nil {
+		return 0, err
+	}
+	if !a.exists(collectionIntegrations.name, c.ProjectID(), integrationID) {
+		return 0, notFound(collectionIntegrations.title)
+	}
+	return integrationID, nil
+}
+
+// presentAlias returns an alias as returned by the API, with the URL of its webhook endpoint instead of the alias.
+func presentAlias(c *call, alias object) object {
+	return object{
+		"id":  alias.Int("id"),
+		"url": "http://" + c.r.Host + "/api/integrations/" + alias.String("alias"),
+	}
+}
+
+func (a *API) getAliases(c *call) (int, any, error) {
+	integrationID, err := a.aliasIntegration(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	aliases := make([]object, 0)
+	for _, alias := range sortedByID(a.collections[collectionIntegrationAliases.name]) {
+		if alias.Int("project_id") == c.ProjectID() && alias.Int("integration_id") == integrationID {
+			aliases = append(aliases, presentAlias(c, alias))
+		}
+	}
+	return http.StatusOK, aliases, nil
+}
+
+func (a *API) createAlias(c *call) (int, any, error) {
+	integrationID, err := a.aliasIntegration(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	alias := object{
+		"alias":      strings.ToLower(rand.Text()[:aliasLength]),
+		"project_id": c.ProjectID(),
+	}
+	if integrationID != 0 {
+		alias["integration_id"] = integrationID
+	}
+	a.insert(collectionIntegrationAliases, alias)
+	return http.StatusOK, presentAlias(c, alias), nil
+}
+
+func (a *API) deleteAlias(c *call) (int, any, error) {
+	integrationID, err := a.aliasIntegration(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	alias, err := a.findObject(c, collectionIntegrationAliases)
+	if err != nil {
+		return 0, nil, err
+	}
+	if alias.Int("integration_id") != integrationID {
+		return 0, nil, notFound(collectionIntegrationAliases.title)
+	}
+	if err := a.remove(collectionIntegrationAliases, alias); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
@@ -0,0 +1,131 @@
+package semaphoretest
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+)
+
+// appIDPattern is the format of application IDs.
+var appIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// defaultApps are the applications of a new SemaphoreUI server, by ID.
+var defaultApps = map[string]object{
+	"ansible":    {"title": "Ansible", "icon": "mdi-ansible", "color": "black", "dark_color": "white", "priority": int64(500)},
+	"terraform":  {"title": "Terraform", "icon": "mdi-terraform", "color": "#7B42BC", "dark_color": "#7B42BC", "priority": int64(90)},
+	"tofu":       {"title": "OpenTofu", "icon": "mdi-language-terraform", "color": "#FFDA18", "dark_color": "#FFDA18", "priority": int64(80)},
+	"terragrunt": {"title": "Terragrunt", "icon": "mdi-layers-outline", "color": "#2A8FD4", "dark_color": "#2A8FD4", "priority": int64(70)},
+	"pulumi":     {"title": "Pulumi", "icon": "mdi-cloud-outline", "color": "#8A3391", "dark_color": "#F7BF2A", "priority": int64(60)},
+	"bash":       {"title": "Bash", "icon": "mdi-bash", "color": "black", "dark_color": "white", "priority": int64(50)},
+	"powershell": {"title": "PowerShell", "icon": "mdi-powershell", "color": "#0077D7", "dark_color": "#0077D7", "priority": int64(40)},
+	"python":     {"title": "Python", "icon": "mdi-language-python", "color": "#3776AB", "dark_color": "#FFD343", "priority": int64(30)},
+}
+
+func newApps() map[string]object {
+	apps := make(map[string]object, len(defaultApps))
+	for id := range defaultApps {
+		apps[id] = newDefaultApp(id)
+	}
+	return apps
+}
+
+// newDefaultApp returns the default application with the ID, as configured on a new server.
+func newDefaultApp(id string) object {
+	app := defaultApps[id].Clone()
+	app["id"] = id
+	app["active"] = true
+	app["path"] = ""
+	return app
+}
+
+func (a *API) appRoutes() {
+	a.handle("GET", "/apps", accessUser, a.getApps)
+	a.handle("GET", "/apps/{app_id}", accessAdmin, a.getApp)
+	a.handle("PUT", "/apps/{app_id}", accessAdmin, a.setApp)
+	a.handle("POST", "/apps/{app_id}/active", accessAdmin, a.setAppActive)
+	a.handle("DELETE", "/apps/{app_id}", accessAdmin, a.deleteApp)
+}
+
+// getApps lists the applications by decreasing priority, then by ID.
+func (a *API) getApps(c *call) (int, any, error) {
+	apps := make([]object, 0, len(a.apps))
+	for _, app := range a.apps {
+		apps = append(apps, app.Clone())
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Int("priority") != apps[j].Int("priority") {
+			return apps[i].Int("priority") > apps[j].Int("priority")
+		}
+		return apps[i].String("id") < apps[j].String("id")
+	})
+	return http.StatusOK, apps, nil
+}
+
+func (a *API) getApp(c *call) (int, any, error) {
+	app := a.apps[c.r.PathValue("app_id")]
+	if app == nil {
+		return 0, nil, notFound("App")
+	}
+	return http.StatusOK, app.Clone(), nil
+}
+
+// setApp updates an application, or adds a custom application when there is no application with the ID. The
+// application takes all the fields of the body, like the SemaphoreUI server.
+func (a *API) setApp(c *call) (int, any, error) {
+	id := c.r.PathValue("app_id")
+	if !appIDPattern.MatchString(id) {
+		return 0, nil, badRequest("Invalid app ID")
+	}
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	a.apps[id] = object{
+		"id":         id,
+		"title":      body.String("title"),
+		"icon":       body.String("icon"),
+		"color":      body.String("color"),
+		"dark_color": body.String("dark_color"),
+		"path":       body.String("path"),
+		"priority":   body.Int("priority"),
+		"active":     body.Bool("active"),
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) setAppActive(c *call) (int, any, error) {
+	app := a.apps[c.r.PathValue("app_id")]
+	if app == nil {
+		return 0, nil, notFound("App")
+	}
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	app["active"] = body.Bool("active")
+	return http.StatusNoContent, nil, nil
+}
+
+// deleteApp removes an application and its configuration, including a default application, like the SemaphoreUI
+// server.
+func (a *API) deleteApp(c *call) (int, any, error) {
+	id := c.r.PathValue("app_id")
+	if a.apps[id] == nil {
+		return 0, nil, notFound("App")
+	}
+	delete(a.apps, id)
+	return http.StatusNoContent, nil, nil
+}
+
+// SetAppActive activates or deactivates an application, for tests of templates using inactive applications.
+func (a *API) SetAppActive(id string, active bool) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	app := a.apps[id]
+	if app == nil {
+		return false
+	}
+	app["active"] = active
+	return true
+}
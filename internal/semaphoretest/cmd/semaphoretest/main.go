@@ -0,0 +1,32 @@
+// Command semaphoretest runs the in-memory fake SemaphoreUI API, to test Terraform configurations using the provider
+// without a SemaphoreUI server. All data is lost when the command exits.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"terraform-provider-semaphoreui/internal/semaphoretest"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:3000", "Address to listen on.")
+	token := flag.String("token", os.Getenv("SEMAPHOREUI_API_TOKEN"), "API token of the admin user. A random token is generated when empty.")
+	flag.Parse()
+
+	api := semaphoretest.NewAPI()
+	if *token != "" {
+		if err := api.RegisterToken(semaphoretest.AdminUserID, *token); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		*token = api.AdminToken
+	}
+
+	fmt.Printf("SEMAPHOREUI_API_BASE_URL=http://%s/api\n", *listen)
+	fmt.Printf("SEMAPHOREUI_API_TOKEN=%s\n", *token)
+	log.Fatal(http.ListenAndServe(*listen, api))
+}
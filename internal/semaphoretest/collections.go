@@ -0,0 +1,607 @@
+package semaphoretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adhocore/gronx"
+)
+
+// deletePolicy is what happens to the objects referencing an object when it is deleted.
+type deletePolicy int
+
+const (
+	// restrict refuses to delete an object that is still referenced.
+	restrict deletePolicy = iota
+	// cascade deletes the referencing objects with the object.
+	cascade
+	// setNull removes the reference from the referencing objects.
+	setNull
+)
+
+// reference is a field holding the ID of an object of another collection in the same project.
+type reference struct {
+	field      string
+	collection string
+	onDelete   deletePolicy
+}
+
+// collection describes a kind of project object, for example the project keys.
+type collection struct {
+	// name is the last path segment of the collection endpoints, and the key the objects are stored under.
+	name string
+	// title is the name of an object of the collection in error messages.
+	title string
+	// parent is the reference to the parent object of nested collections, such as the matchers of an integration.
+	parent *reference
+	// required lists the fields that can not be empty.
+	required []string
+	// references lists the fields referencing objects of other collections.
+	references []reference
+	// createStatus is the status code of a successful create, http.StatusCreated when not set.
+	createStatus int
+	// validate checks the rules specific to the collection. prev is nil when the object is created.
+	validate func(a *API, obj object, prev object) error
+	// save is called before the object is stored, to handle fields that are not stored as sent, such as secrets.
+	save func(a *API, obj object, prev object) error
+	// present returns the object as returned by the API, hiding secrets.
+	present func(obj object) object
+	// noRoutes is set for collections with their own endpoints, such as the tasks.
+	noRoutes bool
+}
+
+// parentField returns the field holding the parent ID of nested collections, or "" for other collections.
+func (col *collection) parentField() string {
+	if col.parent != nil {
+		return col.parent.field
+	}
+	return ""
+}
+
+func (col *collection) presentObject(obj object) object {
+	if col.present != nil {
+		return col.present(obj)
+	}
+	return obj.Clone()
+}
+
+// basePattern returns the path pattern of the collection endpoints.
+func (col *collection) basePattern() string {
+	if col.parent != nil {
+		return fmt.Sprintf("/project/{project_id}/%[1]s/{%[2]s}/%[3]s", col.parent.collection, col.parent.field, col.name)
+	}
+	return "/project/{project_id}/" + col.name
+}
+
+// projectCollections are the project objects of the fake API.
+var projectCollections = []*collection{
+	collectionKeys,
+	collectionRepositories,
+	collectionInventory,
+	collectionEnvironment,
+	collectionViews,
+	collectionTemplates,
+	collectionSchedules,
+	collectionIntegrations,
+	collectionIntegrationMatchers,
+	collectionIntegrationValues,
+	collectionIntegrationAliases,
+	collectionTasks,
+}
+
+var collectionKeys = &collection{
+	name:     "keys",
+	title:    "Access Key",
+	required: []string{"name", "type"},
+	validate: func(a *API, obj object, prev object) error {
+		if !slices.Contains([]string{"none", "login_password", "ssh", "string"}, obj.String("type")) {
+			return badRequest("Invalid access key type %q", obj.String("type"))
+		}
+		return nil
+	},
+	save: func(a *API, obj object, prev object) error {
+		keyType := obj.String("type")
+		override := obj.Bool("override_secret")
+		delete(obj, "override_secret")
+		// Secrets are only changed when the key is created, its type changes, or override_secret is set
+		if prev != nil && !override && keyType == prev.String("type") {
+			for _, secret := range []string{"login_password", "ssh", "string"} {
+				if value, ok := prev[secret]; ok {
+					obj[secret] = cloneValue(value)
+				} else {
+					delete(obj, secret)
+				}
+			}
+			return nil
+		}
+		if keyType == "ssh" {
+			ssh, _ := obj["ssh"].(map[string]any)
+			if ssh == nil || object(ssh).String("private_key") == "" {
+				return badRequest("Access Key ssh private_key can not be empty")
+			}
+		}
+		for _, secret := range []string{"login_password", "ssh", "string"} {
+			if secret != keyType {
+				delete(obj, secret)
+			}
+		}
+		return nil
+	},
+	present: func(obj object) object {
+		return obj.Without("login_password", "ssh", "string", "override_secret")
+	},
+}
+
+var collectionRepositories = &collection{
+	name:     "repositories",
+	title:    "Repository",
+	required: []string{"name", "git_url", "ssh_key_id"},
+	references: []reference{
+		{field: "ssh_key_id", collection: "keys", onDelete: restrict},
+	},
+}
+
+var collectionInventory = &collection{
+	name:     "inventory",
+	title:    "Inventory",
+	required: []string{"name", "type"},
+	references: []reference{
+		{field: "ssh_key_id", collection: "keys", onDelete: restrict},
+		{field: "become_key_id", collection: "keys", onDelete: restrict},
+		{field: "repository_id", collection: "repositories", onDelete: restrict},
+	},
+	validate: func(a *API, obj object, prev object) error {
+		if !slices.Contains([]string{"static", "static-yaml", "file", "terraform-workspace", "tofu-workspace"}, obj.String("type")) {
+			return badRequest("Invalid inventory type %q", obj.String("type"))
+		}
+		return nil
+	},
+}
+
+var collectionEnvironment = &collection{
+	name:     "environment",
+	title:    "Environment",
+	required: []string{"name"},
+	validate: func(a *API, obj object, prev object) error {
+		for _, field := range []string{"json", "env"} {
+			var values map[string]any
+			if value := obj.String(field); value != "" && json.Unmarshal([]byte(value), &values) != nil {
+				return badRequest("Environment %s must be a valid JSON object", field)
+			}
+		}
+		return nil
+	},
+	save: saveEnvironmentSecrets,
+	present: func(obj object) object {
+		presented := obj.Clone()
+		secrets := make([]any, 0)
+		for _, secret := range obj.Objects("secrets") {
+			secrets = append(secrets, map[string]any(secret.Without("secret")))
+		}
+		presented["secrets"] = secrets
+		return presented
+	},
+}
+
+// saveEnvironmentSecrets applies the create, update and delete operations of the secrets in the request, the same
+// way SemaphoreUI does. Secrets without an operation are left unchanged.
+func saveEnvironmentSecrets(a *API, obj object, prev object) error {
+	secrets := make([]object, 0)
+	if prev != nil {
+		for _, secret := range prev.Objects("secrets") {
+			secrets = append(secrets, secret.Clone())
+		}
+	}
+
+	for _, request := range obj.Objects("secrets") {
+		switch request.String("operation") {
+		case "create":
+			if request.String("name") == "" {
+				return badRequest("Environment secret name can not be empty")
+			}
+			if !slices.Contains([]string{"env", "var"}, request.String("type")) {
+				return badRequest("Invalid environment secret type %q", request.String("type"))
+			}
+			secrets = append(secrets, object{
+				"id":     a.nextID("secrets"),
+				"name":   request.String("name"),
+				"type":   request.String("type"),
+				"secret": request.String("secret"),
+			})
+		case "update", "delete":
+			index := slices.IndexFunc(secrets, func(secret object) bool { return secret.Int("id") == request.Int("id") })
+			if index < 0 {
+				return badRequest("Environment secret %d does not exist", request.Int("id"))
+			}
+			if request.String("operation") == "delete" {
+				secrets = slices.Delete(secrets, index, index+1)
+				continue
+			}
+			if request.String("name") != "" {
+				secrets[index]["name"] = request.String("name")
+			}
+			if request.String("secret") != "" {
+				secrets[index]["secret"] = request.String("secret")
+			}
+		case "":
+		default:
+			return badRequest("Invalid environment secret operation %q", request.String("operation"))
+		}
+	}
+
+	stored := make([]any, 0, len(secrets))
+	for _, secret := range secrets {
+		stored = append(stored, map[string]any(secret))
+	}
+	obj["secrets"] = stored
+	return nil
+}
+
+var collectionViews = &collection{
+	name:     "views",
+	title:    "View",
+	required: []string{"title"},
+}
+
+var collectionTemplates = &collection{
+	name:     "templates",
+	title:    "Template",
+	required: []string{"name", "repository_id"},
+	references: []reference{
+		{field: "inventory_id", collection: "inventory", onDelete: restrict},
+		{field: "repository_id", collection: "repositories", onDelete: restrict},
+		{field: "environment_id", collection: "environment", onDelete: restrict},
+		{field: "view_id", collection: "views", onDelete: setNull},
+		{field: "build_template_id", collection: "templates", onDelete: restrict},
+	},
+	validate: validateTemplate,
+}
+
+// validateTemplate checks the rules of SemaphoreUI template validation.
+func validateTemplate(a *API, obj object, prev object) error {
+	app := obj.String("app")
+	if app != "" && a.apps[app] == nil {
+		return badRequest("Invalid template app %q", app)
+	}
+	if (app == "" || app == "ansible") && obj.Int("inventory_id") == 0 {
+		return badRequest("Template inventory can not be empty")
+	}
+	if !slices.Contains([]string{"terraform", "tofu", "terragrunt"}, app) && obj.String("playbook") == "" {
+		return badRequest("Template playbook can not be empty")
+	}
+	if arguments := obj.String("arguments"); arguments != "" {
+		var values []string
+		if json.Unmarshal([]byte(arguments), &values) != nil {
+			return badRequest("Template arguments must be a valid JSON array of strings")
+		}
+	}
+
+	switch obj.String("type") {
+	case "", "build":
+		if obj.Int("build_template_id") != 0 {
+			return badRequest("Only deploy templates can have a build template")
+		}
+	case "deploy":
+		build := a.collections["templates"][obj.Int("build_template_id")]
+		if build == nil {
+			return badRequest("Template build_template_id can not be empty for deploy templates")
+		}
+		if build.String("type") != "build" {
+			return badRequest("Template build_template_id must reference a build template")
+		}
+	default:
+		return badRequest("Invalid template type %q", obj.String("type"))
+	}
+
+	for _, vault := range obj.Objects("vaults") {
+		if keyID := vault.Int("vault_key_id"); keyID != 0 && !a.exists("keys", obj.Int("project_id"), keyID) {
+			return badRequest("Template vault key %d does not exist", keyID)
+		}
+	}
+	return nil
+}
+
+var collectionSchedules = &collection{
+	name:     "schedules",
+	title:    "Schedule",
+	required: []string{"template_id"},
+	references: []reference{
+		{field: "template_id", collection: "templates", onDelete: cascade},
+	},
+	validate: func(a *API, obj object, prev object) error {
+		if err := validateOneOf(obj, map[string][]string{"type": {"", "run_at"}}); err != nil {
+			return err
+		}
+		// run_at schedules run once at run_at, other schedules run on cron_format
+		if obj.String("type") == "run_at" {
+			if _, err := time.Parse(time.RFC3339, obj.String("run_at")); err != nil {
+				return badRequest("Invalid run at %q", obj.String("run_at"))
+			}
+			return nil
+		}
+		if !gronx.New().IsValid(obj.String("cron_format")) {
+			return badRequest("Invalid cron format %q", obj.String("cron_format"))
+		}
+		return nil
+	},
+}
+
+var collectionIntegrations = &collection{
+	name:     "integrations",
+	title:    "Integration",
+	required: []string{"name", "template_id"},
+	references: []reference{
+		{field: "template_id", collection: "templates", onDelete: cascade},
+		{field: "auth_secret_id", collection: "keys", onDelete: restrict},
+	},
+	validate: func(a *API, obj object, prev object) error {
+		if !slices.Contains([]string{"", "none", "token", "github", "bitbucket", "hmac", "basic"}, obj.String("auth_method")) {
+			return badRequest("Invalid integration auth method %q", obj.String("auth_method"))
+		}
+		return nil
+	},
+}
+
+var collectionIntegrationMatchers = &collection{
+	name:         "matchers",
+	title:        "Integration Matcher",
+	parent:       &reference{field: "integration_id", collection: "integrations", onDelete: cascade},
+	required:     []string{"name", "match_type", "method"},
+	createStatus: http.StatusOK,
+	validate: func(a *API, obj object, prev object) error {
+		return validateOneOf(obj, map[string][]string{
+			"match_type":     {"body", "header"},
+			"method":         {"equals", "unequals", "contains"},
+			"body_data_type": {"", "json", "xml", "string"},
+		})
+	},
+}
+
+var collectionIntegrationValues = &collection{
+	name:     "values",
+	title:    "Integration Extract Value",
+	parent:   &reference{field: "integration_id", collection: "integrations", onDelete: cascade},
+	required: []string{"name", "value_source", "variable"},
+	validate: func(a *API, obj object, prev object) error {
+		return validateOneOf(obj, map[string][]string{
+			"value_source":   {"body", "header"},
+			"body_data_type": {"", "json", "xml", "string"},
+			"variable_type":  {"", "environment", "task"},
+		})
+	},
+}
+
+// validateOneOf checks the fields only have one of the allowed values.
+func validateOneOf(obj object, allowed map[string][]string) error {
+	for field, values := range allowed {
+		if !slices.Contains(values, obj.String(field)) {
+			return badRequest("Invalid %s %q, must be one of: %s", field, obj.String(field), strings.Join(values, ", "))
+		}
+	}
+	return nil
+}
+
+// definition returns the collection with the given name.
+func definition(name string) *collection {
+	for _, col := range projectCollections {
+		if col.name == name {
+			return col
+		}
+	}
+	panic("unknown collection " + name)
+}
+
+// exists returns whether an object of the collection exists in the project.
+func (a *API) exists(name string, projectID int64, id int64) bool {
+	obj := a.collections[name][id]
+	return obj != nil && obj.Int("project_id") == projectID
+}
+
+// insert stores a new object in the collection, assigning its ID.
+func (a *API) insert(col *collection, obj object) object {
+	if a.collections[col.name] == nil {
+		a.collections[col.name] = map[int64]object{}
+	}
+	obj["id"] = a.nextID(col.name)
+	a.collections[col.name][obj.Int("id")] = obj
+	return obj
+}
+
+// check validates an object before it is stored.
+func (a *API) check(col *collection, obj object, prev object) error {
+	for _, field := range col.required {
+		if obj.Int(field) == 0 && obj.String(field) == "" {
+			return badRequest("%s %s can not be empty", col.title, field)
+		}
+	}
+	for _, ref := range col.references {
+		if id := obj.Int(ref.field); id != 0 && !a.exists(ref.collection, obj.Int("project_id"), id) {
+			return badRequest("%s %s %d does not exist in the project", col.title, ref.field, id)
+		}
+	}
+	if col.validate != nil {
+		if err := col.validate(a, obj, prev); err != nil {
+			return err
+		}
+	}
+	if col.save != nil {
+		return col.save(a, obj, prev)
+	}
+	return nil
+}
+
+// remove deletes an object, applying the delete policy of the objects referencing it.
+func (a *API) remove(col *collection, obj object) error {
+	id := obj.Int("id")
+	for _, other := range projectCollections {
+		refs := other.references
+		if other.parent != nil {
+			refs = append(slices.Clone(refs), *other.parent)
+		}
+		for _, ref := range refs {
+			if ref.collection != col.name {
+				continue
+			}
+			for _, referencing := range sortedByID(a.collections[other.name]) {
+				if referencing.Int(ref.field) != id || referencing.Int("project_id") != obj.Int("project_id") {
+					continue
+				}
+				switch ref.onDelete {
+				case restrict:
+					return badRequest("%s is in use by %s %d", col.title, other.title, referencing.Int("id"))
+				case cascade:
+					if err := a.remove(other, referencing); err != nil {
+						return err
+					}
+				case setNull:
+					delete(referencing, ref.field)
+				}
+			}
+		}
+	}
+	delete(a.collections[col.name], id)
+	return nil
+}
+
+// findObject returns the object of the collection with the ID of the object_id path parameter.
+func (a *API) findObject(c *call, col *collection) (object, error) {
+	id, err := c.PathID("object_id")
+	if err != nil {
+		return nil, err
+	}
+	obj := a.collections[col.name][id]
+	if obj == nil || obj.Int("project_id") != c.ProjectID() {
+		return nil, notFound(col.title)
+	}
+	if col.parent != nil {
+		parentID, err := c.PathID(col.parent.field)
+		if err != nil {
+			return nil, err
+		}
+		if obj.Int(col.parent.field) != parentID {
+			return nil, notFound(col.title)
+		}
+	}
+	return obj, nil
+}
+
+// findParent returns the ID of the parent object of a nested collection, or 0 for other collections.
+func (a *API) findParent(c *call, col *collection) (int64, error) {
+	if col.parent == nil {
+		return 0, nil
+	}
+	parentID, err := c.PathID(col.parent.field)
+	if err != nil {
+		return 0, err
+	}
+	if !a.exists(col.parent.collection, c.ProjectID(), parentID) {
+		return 0, notFound(definition(col.parent.collection).title)
+	}
+	return parentID, nil
+}
+
+// requestObject returns the object sent in the request body, with the fields set by the URL. The ID is 0 when the
+// object is created.
+func (a *API) requestObject(c *call, col *collection, parentID int64, id int64) (object, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	if bodyID := body.Int("id"); id != 0 && bodyID != 0 && bodyID != id {
+		return nil, badRequest("%s ID in body and URL must be the same", col.title)
+	}
+	if projectID := body.Int("project_id"); projectID != 0 && projectID != c.ProjectID() {
+		return nil, badRequest("Project ID in body and URL must be the same")
+	}
+	obj := body.Without("id")
+	if id != 0 {
+		obj["id"] = id
+	}
+	obj["project_id"] = c.ProjectID()
+	if col.parent != nil {
+		obj[col.parent.field] = parentID
+	}
+	return obj, nil
+}
+
+// collectionRoutes registers the list, create, read, update and delete endpoints of a collection.
+func (a *API) collectionRoutes(col *collection) {
+	if col.noRoutes {
+		return
+	}
+	base := col.basePattern()
+
+	a.handle("GET", base, accessProjectGuest, func(c *call) (int, any, error) {
+		parentID, err := a.findParent(c, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		objects := make([]object, 0)
+		for _, obj := range sortedByID(a.collections[col.name]) {
+			if obj.Int("project_id") == c.ProjectID() && (col.parent == nil || obj.Int(col.parent.field) == parentID) {
+				objects = append(objects, col.presentObject(obj))
+			}
+		}
+		return http.StatusOK, objects, nil
+	})
+
+	a.handle("POST", base, accessProjectManager, func(c *call) (int, any, error) {
+		parentID, err := a.findParent(c, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		obj, err := a.requestObject(c, col, parentID, 0)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := a.check(col, obj, nil); err != nil {
+			return 0, nil, err
+		}
+		a.insert(col, obj)
+
+		status := col.createStatus
+		if status == 0 {
+			status = http.StatusCreated
+		}
+		return status, col.presentObject(obj), nil
+	})
+
+	a.handle("GET", base+"/{object_id}", accessProjectGuest, func(c *call) (int, any, error) {
+		obj, err := a.findObject(c, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, col.presentObject(obj), nil
+	})
+
+	a.handle("PUT", base+"/{object_id}", accessProjectManager, func(c *call) (int, any, error) {
+		prev, err := a.findObject(c, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		obj, err := a.requestObject(c, col, prev.Int(col.parentField()), prev.Int("id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := a.check(col, obj, prev); err != nil {
+			return 0, nil, err
+		}
+		a.collections[col.name][obj.Int("id")] = obj
+		return http.StatusNoContent, nil, nil
+	})
+
+	a.handle("DELETE", base+"/{object_id}", accessProjectManager, func(c *call) (int, any, error) {
+		obj, err := a.findObject(c, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := a.remove(col, obj); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	})
+}
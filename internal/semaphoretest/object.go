@@ -0,0 +1,120 @@
+package semaphoretest
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// object is a JSON object stored by the fake API. Objects are stored as decoded JSON rather than as the generated
+// models, so fields the fake doesn't know about are kept and returned like the real API does for its own fields.
+type object map[string]any
+
+// Int returns the integer value of a field, or 0 when it is missing or not a number.
+func (o object) Int(key string) int64 {
+	switch value := o[key].(type) {
+	case json.Number:
+		i, err := value.Int64()
+		if err != nil {
+			f, _ := value.Float64()
+			return int64(f)
+		}
+		return i
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case float64:
+		return int64(value)
+	case string:
+		i, _ := strconv.ParseInt(value, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// String returns the string value of a field, or "" when it is missing or not a string.
+func (o object) String(key string) string {
+	if value, ok := o[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// Bool returns the boolean value of a field, or false when it is missing or not a boolean.
+func (o object) Bool(key string) bool {
+	if value, ok := o[key].(bool); ok {
+		return value
+	}
+	return false
+}
+
+// Objects returns the list of objects of a field, skipping any element that is not an object.
+func (o object) Objects(key string) []object {
+	values, _ := o[key].([]any)
+	objects := make([]object, 0, len(values))
+	for _, value := range values {
+		switch value := value.(type) {
+		case map[string]any:
+			objects = append(objects, value)
+		case object:
+			objects = append(objects, value)
+		}
+	}
+	return objects
+}
+
+// Clone returns a deep copy of the object so stored objects are never shared with handlers.
+func (o object) Clone() object {
+	clone := make(object, len(o))
+	for key, value := range o {
+		clone[key] = cloneValue(value)
+	}
+	return clone
+}
+
+func cloneValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		return map[string]any(object(value).Clone())
+	case object:
+		return value.Clone()
+	case []any:
+		clone := make([]any, len(value))
+		for i, element := range value {
+			clone[i] = cloneValue(element)
+		}
+		return clone
+	case []object:
+		clone := make([]any, len(value))
+		for i, element := range value {
+			clone[i] = element.Clone()
+		}
+		return clone
+	}
+	return value
+}
+
+// Without returns a copy of the object without the given fields.
+func (o object) Without(keys ...string) object {
+	clone := o.Clone()
+	for _, key := range keys {
+		delete(clone, key)
+	}
+	return clone
+}
+
+// sortedByID returns the objects of the map ordered by ID, which is the order the real API lists most objects in.
+func sortedByID(objects map[int64]object) []object {
+	ids := make([]int64, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	sorted := make([]object, 0, len(ids))
+	for _, id := range ids {
+		sorted = append(sorted, objects[id])
+	}
+	return sorted
+}
@@ -0,0 +1,189 @@
+package semaphoretest
+
+import (
+	"net/http"
+	"sort"
+)
+
+func (a *API) projectRoutes() {
+	a.handle("GET", "/projects", accessUser, a.getProjects)
+	a.handle("POST", "/projects", accessAdmin, a.createProject)
+	for _, pattern := range []string{"/project/{project_id}/{$}", "/project/{project_id}"} {
+		a.handle("GET", pattern, accessProjectGuest, a.getProject)
+		a.handle("PUT", pattern, accessProjectOwner, a.updateProject)
+		a.handle("DELETE", pattern, accessProjectOwner, a.deleteProject)
+	}
+	a.handle("GET", "/project/{project_id}/role", accessProjectGuest, a.getProjectRole)
+
+	a.handle("GET", "/project/{project_id}/users", accessProjectGuest, a.getProjectUsers)
+	a.handle("POST", "/project/{project_id}/users", accessProjectOwner, a.addProjectUser)
+	a.handle("PUT", "/project/{project_id}/users/{user_id}", accessProjectOwner, a.updateProjectUser)
+	a.handle("DELETE", "/project/{project_id}/users/{user_id}", accessProjectOwner, a.removeProjectUser)
+}
+
+func (a *API) getProjects(c *call) (int, any, error) {
+	projects := make([]object, 0)
+	for _, project := range sortedByID(a.projects) {
+		if _, member := a.members[project.Int("id")][c.user.Int("id")]; member || c.user.Bool("admin") {
+			projects = append(projects, project.Clone())
+		}
+	}
+	return http.StatusOK, projects, nil
+}
+
+// setProjectFields copies the fields that can be set through the API from the request body to the project.
+func setProjectFields(project object, body object) error {
+	if body.String("name") == "" {
+		return badRequest("Project name can not be empty")
+	}
+	if body.Int("max_parallel_tasks") < 0 {
+		return badRequest("Max parallel tasks can not be negative")
+	}
+	project["name"] = body.String("name")
+	project["alert"] = body.Bool("alert")
+	project["alert_chat"] = body.String("alert_chat")
+	project["max_parallel_tasks"] = body.Int("max_parallel_tasks")
+	project["type"] = body.String("type")
+	return nil
+}
+
+func (a *API) createProject(c *call) (int, any, error) {
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	project := object{"created": now()}
+	if err := setProjectFields(project, body); err != nil {
+		return 0, nil, err
+	}
+	id := a.nextID("projects")
+	project["id"] = id
+	a.projects[id] = project
+	a.members[id] = map[int64]string{c.user.Int("id"): "owner"}
+
+	// SemaphoreUI creates the None key in every new project
+	if !body.Bool("demo") {
+		a.insert(collectionKeys, object{
+			"name":       "None",
+			"type":       "none",
+			"project_id": id,
+		})
+	}
+	return http.StatusCreated, project.Clone(), nil
+}
+
+func (a *API) getProject(c *call) (int, any, error) {
+	return http.StatusOK, c.project.Clone(), nil
+}
+
+func (a *API) updateProject(c *call) (int, any, error) {
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if body.Int("id") != c.ProjectID() {
+		return 0, nil, badRequest("Project ID in body and URL must be the same")
+	}
+	if err := setProjectFields(c.project, body); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) deleteProject(c *call) (int, any, error) {
+	id := c.ProjectID()
+	for _, objects := range a.collections {
+		for objectID, obj := range objects {
+			if obj.Int("project_id") == id {
+				delete(objects, objectID)
+			}
+		}
+	}
+	delete(a.members, id)
+	delete(a.projects, id)
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) getProjectRole(c *call) (int, any, error) {
+	role := a.members[c.ProjectID()][c.user.Int("id")]
+	return http.StatusOK, object{"role": role}, nil
+}
+
+func (a *API) getProjectUsers(c *call) (int, any, error) {
+	users := make([]object, 0)
+	for userID, role := range a.members[c.ProjectID()] {
+		user := a.users[userID]
+		users = append(users, object{
+			"id":       userID,
+			"username": user.String("username"),
+			"name":     user.String("name"),
+			"role":     role,
+		})
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].String("name") < users[j].String("name") })
+	return http.StatusOK, users, nil
+}
+
+// validateProjectRole checks the role of a project user in a request body.
+func validateProjectRole(body object) error {
+	if _, ok := projectRoles[body.String("role")]; !ok {
+		return badRequest("Invalid project role %q", body.String("role"))
+	}
+	return nil
+}
+
+func (a *API) addProjectUser(c *call) (int, any, error) {
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := validateProjectRole(body); err != nil {
+		return 0, nil, err
+	}
+	userID := body.Int("user_id")
+	if a.users[userID] == nil {
+		return 0, nil, badRequest("User %d does not exist", userID)
+	}
+	if _, member := a.members[c.ProjectID()][userID]; member {
+		return 0, nil, badRequest("User %d is already a member of the project", userID)
+	}
+	a.members[c.ProjectID()][userID] = body.String("role")
+	return http.StatusNoContent, nil, nil
+}
+
+// findProjectUser returns the ID of the project member of the user_id path parameter.
+func (a *API) findProjectUser(c *call) (int64, error) {
+	userID, err := c.PathID("user_id")
+	if err != nil {
+		return 0, err
+	}
+	if _, member := a.members[c.ProjectID()][userID]; !member {
+		return 0, notFound("User")
+	}
+	return userID, nil
+}
+
+func (a *API) updateProjectUser(c *call) (int, any, error) {
+	userID, err := a.findProjectUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := validateProjectRole(body); err != nil {
+		return 0, nil, err
+	}
+	a.members[c.ProjectID()][userID] = body.String("role")
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) removeProjectUser(c *call) (int, any, error) {
+	userID, err := a.findProjectUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	delete(a.members[c.ProjectID()], userID)
+	return http.StatusNoContent, nil, nil
+}
@@ -0,0 +1,338 @@
+// Package semaphoretest provides an in-memory fake of the SemaphoreUI API, implementing the endpoints of
+// api-docs.yml used by the provider. It allows the provider acceptance tests, and Terraform modules built on the
+// provider, to run without a SemaphoreUI server.
+//
+// The fake mimics the validation and error codes of SemaphoreUI closely enough for the provider to behave the same
+// way against both, but it does not run tasks or connect to repositories.
+package semaphoretest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// AdminUserID is the ID of the admin user that exists in a new fake API.
+	AdminUserID int64 = 1
+	// AdminUsername is the username of the admin user that exists in a new fake API.
+	AdminUsername string = "admin"
+	// AdminEmail is the email of the admin user that exists in a new fake API.
+	AdminEmail string = "admin@localhost"
+)
+
+// Server is a fake SemaphoreUI API listening on a local address.
+type Server struct {
+	*httptest.Server
+	API *API
+}
+
+// NewServer starts a fake SemaphoreUI API on a random local port. The server must be closed when it is no longer used.
+func NewServer() *Server {
+	api := NewAPI()
+	return &Server{
+		Server: httptest.NewServer(api),
+		API:    api,
+	}
+}
+
+// APIBaseURL returns the base URL of the API, as used by the provider `api_base_url` attribute.
+func (s *Server) APIBaseURL() string {
+	return s.URL + "/api"
+}
+
+// API is the http.Handler implementing the fake SemaphoreUI API. All data is kept in memory.
+type API struct {
+	mu  sync.Mutex
+	mux *http.ServeMux
+
+	nextIDs     map[string]int64
+	tokens      map[string]object
+	users       map[int64]object
+	passwords   map[int64]string
+	projects    map[int64]object
+	members     map[int64]map[int64]string
+	collections map[string]map[int64]object
+	apps        map[string]object
+
+	// AdminToken is an API token of the admin user.
+	AdminToken string
+}
+
+// NewAPI returns a fake SemaphoreUI API containing only the admin user, with an API token in AdminToken.
+func NewAPI() *API {
+	a := &API{
+		mux:         http.NewServeMux(),
+		nextIDs:     map[string]int64{},
+		tokens:      map[string]object{},
+		users:       map[int64]object{},
+		passwords:   map[int64]string{},
+		projects:    map[int64]object{},
+		members:     map[int64]map[int64]string{},
+		collections: map[string]map[int64]object{},
+		apps:        newApps(),
+	}
+
+	admin := a.nextID("users")
+	a.users[admin] = object{
+		"id":       admin,
+		"username": AdminUsername,
+		"name":     AdminUsername,
+		"email":    AdminEmail,
+		"admin":    true,
+		"alert":    false,
+		"external": false,
+		"created":  now(),
+	}
+	a.passwords[admin] = "admin"
+	a.AdminToken = a.addToken(admin)
+
+	a.routes()
+	return a
+}
+
+// AddToken creates an API token for the user, for tests that act as a non-admin user.
+func (a *API) AddToken(userID int64) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.users[userID]; !ok {
+		return "", fmt.Errorf("user %d does not exist", userID)
+	}
+	return a.addToken(userID), nil
+}
+
+// RegisterToken adds a known API token for the user, for example to start the fake API with the token used by a
+// Terraform configuration.
+func (a *API) RegisterToken(userID int64, token string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.users[userID]; !ok {
+		return fmt.Errorf("user %d does not exist", userID)
+	}
+	if token == "" {
+		return errors.New("token can not be empty")
+	}
+	a.registerToken(userID, token)
+	return nil
+}
+
+func (a *API) addToken(userID int64) string {
+	bytes := make([]byte, 32)
+	_, _ = rand.Read(bytes)
+	token := base64.RawURLEncoding.EncodeToString(bytes)
+	a.registerToken(userID, token)
+	return token
+}
+
+func (a *API) registerToken(userID int64, token string) {
+	a.tokens[token] = object{
+		"id":      token,
+		"user_id": userID,
+		"created": now(),
+		"expired": false,
+	}
+}
+
+func (a *API) nextID(kind string) int64 {
+	a.nextIDs[kind]++
+	return a.nextIDs[kind]
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// access is the permission required to call an endpoint.
+type access int
+
+const (
+	// accessPublic endpoints don't require authentication.
+	accessPublic access = iota
+	// accessUser endpoints require any authenticated user.
+	accessUser
+	// accessAdmin endpoints require an admin user.
+	accessAdmin
+	// accessProjectGuest endpoints require any role in the project.
+	accessProjectGuest
+	// accessProjectTaskRunner endpoints require a role that can run tasks in the project.
+	accessProjectTaskRunner
+	// accessProjectManager endpoints require a role that can manage the project resources.
+	accessProjectManager
+	// accessProjectOwner endpoints require the owner role in the project.
+	accessProjectOwner
+)
+
+var projectRoles = map[string]access{
+	"guest":       accessProjectGuest,
+	"task_runner": accessProjectTaskRunner,
+	"manager":     accessProjectManager,
+	"owner":       accessProjectOwner,
+}
+
+// call is an authenticated API request.
+type call struct {
+	r       *http.Request
+	user    object
+	project object
+}
+
+// ProjectID returns the ID of the project of a project endpoint.
+func (c *call) ProjectID() int64 {
+	return c.project.Int("id")
+}
+
+// PathID returns the integer path parameter with the given name.
+func (c *call) PathID(name string) (int64, error) {
+	id, err := strconv.ParseInt(c.r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, badRequest("Invalid %s", name)
+	}
+	return id, nil
+}
+
+// Body decodes the JSON object request body.
+func (c *call) Body() (object, error) {
+	var body object
+	decoder := json.NewDecoder(c.r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil || body == nil {
+		return nil, badRequest("Invalid JSON body")
+	}
+	return body, nil
+}
+
+// handler handles an API call, returning the response status code and body. A nil body writes no content.
+type handler func(c *call) (int, any, error)
+
+// apiError is an error returned to the client with an HTTP status code.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...any) error {
+	return &apiError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(title string) error {
+	return &apiError{status: http.StatusNotFound, message: title + " not found"}
+}
+
+func forbidden() error {
+	return &apiError{status: http.StatusForbidden, message: "Forbidden"}
+}
+
+// handle registers a handler for the method and path patterns, relative to /api.
+func (a *API) handle(method string, pattern string, required access, h handler) {
+	a.mux.HandleFunc(method+" /api"+pattern, func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		c := &call{r: r}
+		var status int
+		var body any
+		err := a.authorize(c, required)
+		if err == nil {
+			status, body, err = h(c)
+		}
+
+		var apiErr *apiError
+		if errors.As(err, &apiErr) {
+			status, body = apiErr.status, map[string]any{"error": apiErr.message}
+		} else if err != nil {
+			status, body = http.StatusInternalServerError, map[string]any{"error": err.Error()}
+		}
+
+		if body == nil {
+			w.WriteHeader(status)
+			return
+		}
+		if text, ok := body.(string); ok {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(text))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
+
+// authorize authenticates the call and checks the user has the required access.
+func (a *API) authorize(c *call, required access) error {
+	if required == accessPublic {
+		return nil
+	}
+
+	token, ok := strings.CutPrefix(c.r.Header.Get("Authorization"), "Bearer ")
+	if !ok || a.tokens[token] == nil || a.tokens[token].Bool("expired") {
+		return &apiError{status: http.StatusUnauthorized, message: "Unauthorized"}
+	}
+	c.user = a.users[a.tokens[token].Int("user_id")]
+	if c.user == nil {
+		return &apiError{status: http.StatusUnauthorized, message: "Unauthorized"}
+	}
+	admin := c.user.Bool("admin")
+
+	switch required {
+	case accessUser:
+		return nil
+	case accessAdmin:
+		if !admin {
+			return forbidden()
+		}
+		return nil
+	}
+
+	projectID, err := c.PathID("project_id")
+	if err != nil {
+		return err
+	}
+	c.project = a.projects[projectID]
+	if c.project == nil {
+		return notFound("Project")
+	}
+	if admin {
+		return nil
+	}
+	role, member := a.members[projectID][c.user.Int("id")]
+	if !member || projectRoles[role] < required {
+		return forbidden()
+	}
+	return nil
+}
+
+// routes registers all endpoints of the fake API.
+func (a *API) routes() {
+	a.handle("GET", "/ping", accessPublic, func(c *call) (int, any, error) {
+		return http.StatusOK, "pong", nil
+	})
+
+	a.userRoutes()
+	a.projectRoutes()
+	for _, col := range projectCollections {
+		a.collectionRoutes(col)
+	}
+	a.aliasRoutes()
+	a.taskRoutes()
+	a.appRoutes()
+}
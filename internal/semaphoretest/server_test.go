@@ -0,0 +1,503 @@
+package semaphoretest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/projects"
+	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+func testServer(t *testing.T) (*Server, *apiclient.SemaphoreUI) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	return server, testServerClient(t, server, server.API.AdminToken)
+}
+
+func testServerClient(t *testing.T, server *Server, token string) *apiclient.SemaphoreUI {
+	t.Helper()
+	u, err := url.Parse(server.APIBaseURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := httptransport.New(u.Host, u.Path, []string{u.Scheme})
+	rt.DefaultAuthentication = httptransport.BearerToken(token)
+	return apiclient.New(rt, strfmt.Default)
+}
+
+// expectStatus fails the test unless err is an API error with the status code.
+func expectStatus(t *testing.T, err error, code int) {
+	t.Helper()
+	var apiErr *runtime.APIError
+	var coder interface{ Code() int }
+	switch {
+	case err == nil:
+		t.Fatalf("expected status %d, got no error", code)
+	case errors.As(err, &apiErr):
+		if apiErr.Code != code {
+			t.Fatalf("expected status %d, got %d: %v", code, apiErr.Code, err)
+		}
+	case errors.As(err, &coder):
+		if coder.Code() != code {
+			t.Fatalf("expected status %d, got %d: %v", code, coder.Code(), err)
+		}
+	default:
+		t.Fatalf("expected status %d, got %v", code, err)
+	}
+}
+
+func mustNot(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testCreateProject(t *testing.T, client *apiclient.SemaphoreUI) int64 {
+	t.Helper()
+	response, err := client.Projects.PostProjects(&projects.PostProjectsParams{Project: &models.ProjectRequest{Name: "Test"}}, nil)
+	mustNot(t, err)
+	return response.Payload.ID
+}
+
+func TestServer_authentication(t *testing.T) {
+	server, client := testServer(t)
+
+	_, err := testServerClient(t, server, "invalid").User.GetUser(&user.GetUserParams{}, nil)
+	expectStatus(t, err, 401)
+
+	response, err := client.User.GetUser(&user.GetUserParams{}, nil)
+	mustNot(t, err)
+	if response.Payload.ID != AdminUserID || response.Payload.Username != AdminUsername || !response.Payload.Admin {
+		t.Fatalf("unexpected current user: %+v", response.Payload)
+	}
+}
+
+func TestServer_projects(t *testing.T) {
+	_, client := testServer(t)
+
+	_, err := client.Projects.PostProjects(&projects.PostProjectsParams{Project: &models.ProjectRequest{}}, nil)
+	expectStatus(t, err, 400)
+
+	projectID := testCreateProject(t, client)
+
+	// New projects contain the None key and the creator as owner
+	keys, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	if len(keys.Payload) != 1 || keys.Payload[0].Name != "None" || keys.Payload[0].Type != "none" {
+		t.Fatalf("unexpected keys: %+v", keys.Payload)
+	}
+	users, err := client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	if len(users.Payload) != 1 || users.Payload[0].ID != AdminUserID || users.Payload[0].Role != "owner" {
+		t.Fatalf("unexpected project users: %+v", users.Payload)
+	}
+
+	body := project.PutProjectProjectIDBody{ID: projectID + 1}
+	body.Name = "Renamed"
+	_, err = client.Project.PutProjectProjectID(&project.PutProjectProjectIDParams{ProjectID: projectID, Project: body}, nil)
+	expectStatus(t, err, 400)
+	body.ID = projectID
+	_, err = client.Project.PutProjectProjectID(&project.PutProjectProjectIDParams{ProjectID: projectID, Project: body}, nil)
+	mustNot(t, err)
+	response, err := client.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	if response.Payload.Name != "Renamed" {
+		t.Fatalf("unexpected project name: %s", response.Payload.Name)
+	}
+
+	_, err = client.Project.DeleteProjectProjectID(&project.DeleteProjectProjectIDParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	_, err = client.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: projectID}, nil)
+	expectStatus(t, err, 404)
+}
+
+func TestServer_keys(t *testing.T) {
+	server, client := testServer(t)
+	projectID := testCreateProject(t, client)
+
+	_, err := client.Project.PostProjectProjectIDKeys(&project.PostProjectProjectIDKeysParams{ProjectID: projectID, AccessKey: &models.AccessKeyRequest{
+		Name: "Invalid",
+		Type: "unknown",
+	}}, nil)
+	expectStatus(t, err, 400)
+
+	response, err := client.Project.PostProjectProjectIDKeys(&project.PostProjectProjectIDKeysParams{ProjectID: projectID, AccessKey: &models.AccessKeyRequest{
+		Name:          "Password",
+		ProjectID:     projectID,
+		Type:          "login_password",
+		LoginPassword: &models.AccessKeyRequestLoginPassword{Login: "user", Password: "secret"},
+	}}, nil)
+	mustNot(t, err)
+	keyID := response.Payload.ID
+
+	stored := server.API.collections["keys"][keyID]
+	loginPassword, _ := stored["login_password"].(map[string]any)
+	if object(loginPassword).String("password") != "secret" {
+		t.Fatalf("secret was not stored: %+v", stored)
+	}
+	if _, ok := collectionKeys.presentObject(stored)["login_password"]; ok {
+		t.Fatalf("secret is returned by the API: %+v", stored)
+	}
+
+	_, err = client.Project.PutProjectProjectIDKeysKeyID(&project.PutProjectProjectIDKeysKeyIDParams{ProjectID: projectID, KeyID: keyID, AccessKey: &models.AccessKeyRequest{
+		ID:        keyID + 1,
+		Name:      "Password",
+		ProjectID: projectID,
+		Type:      "login_password",
+	}}, nil)
+	expectStatus(t, err, 400)
+
+	repository, err := client.Project.PostProjectProjectIDRepositories(&project.PostProjectProjectIDRepositoriesParams{ProjectID: projectID, Repository: &models.RepositoryRequest{
+		Name:     "Repository",
+		GitURL:   "https://example.com/repo.git",
+		SSHKeyID: keyID,
+	}}, nil)
+	mustNot(t, err)
+
+	_, err = client.Project.DeleteProjectProjectIDKeysKeyID(&project.DeleteProjectProjectIDKeysKeyIDParams{ProjectID: projectID, KeyID: keyID}, nil)
+	expectStatus(t, err, 400)
+
+	_, err = client.Project.DeleteProjectProjectIDRepositoriesRepositoryID(&project.DeleteProjectProjectIDRepositoriesRepositoryIDParams{ProjectID: projectID, RepositoryID: repository.Payload.ID}, nil)
+	mustNot(t, err)
+	_, err = client.Project.DeleteProjectProjectIDKeysKeyID(&project.DeleteProjectProjectIDKeysKeyIDParams{ProjectID: projectID, KeyID: keyID}, nil)
+	mustNot(t, err)
+}
+
+func TestServer_environmentSecrets(t *testing.T) {
+	_, client := testServer(t)
+	projectID := testCreateProject(t, client)
+
+	response, err := client.Project.PostProjectProjectIDEnvironment(&project.PostProjectProjectIDEnvironmentParams{ProjectID: projectID, Environment: &models.EnvironmentRequest{
+		Name: "Environment",
+		JSON: "{}",
+		Env:  "{}",
+		Secrets: []*models.EnvironmentSecretRequest{
+			{Name: "TOKEN", Type: "env", Secret: "secret", Operation: "create"},
+			{Name: "password", Type: "var", Secret: "secret", Operation: "create"},
+		},
+	}}, nil)
+	mustNot(t, err)
+	environmentID := response.Payload.ID
+
+	environment, err := client.Project.GetProjectProjectIDEnvironmentEnvironmentID(&project.GetProjectProjectIDEnvironmentEnvironmentIDParams{ProjectID: projectID, EnvironmentID: environmentID}, nil)
+	mustNot(t, err)
+	if len(environment.Payload.Secrets) != 2 {
+		t.Fatalf("unexpected secrets: %+v", environment.Payload.Secrets)
+	}
+	tokenID, passwordID := environment.Payload.Secrets[0].ID, environment.Payload.Secrets[1].ID
+
+	_, err = client.Project.PutProjectProjectIDEnvironmentEnvironmentID(&project.PutProjectProjectIDEnvironmentEnvironmentIDParams{ProjectID: projectID, EnvironmentID: environmentID, Environment: &models.EnvironmentRequest{
+		ID:   environmentID,
+		Name: "Environment",
+		Secrets: []*models.EnvironmentSecretRequest{
+			{ID: tokenID, Name: "API_TOKEN", Type: "env", Operation: "update"},
+			{ID: passwordID, Type: "var", Operation: "delete"},
+		},
+	}}, nil)
+	mustNot(t, err)
+
+	environment, err = client.Project.GetProjectProjectIDEnvironmentEnvironmentID(&project.GetProjectProjectIDEnvironmentEnvironmentIDParams{ProjectID: projectID, EnvironmentID: environmentID}, nil)
+	mustNot(t, err)
+	if len(environment.Payload.Secrets) != 1 || environment.Payload.Secrets[0].Name != "API_TOKEN" {
+		t.Fatalf("unexpected secrets: %+v", environment.Payload.Secrets)
+	}
+
+	_, err = client.Project.PutProjectProjectIDEnvironmentEnvironmentID(&project.PutProjectProjectIDEnvironmentEnvironmentIDParams{ProjectID: projectID, EnvironmentID: environmentID, Environment: &models.EnvironmentRequest{
+		ID:   environmentID,
+		Name: "Environment",
+		JSON: "not json",
+	}}, nil)
+	expectStatus(t, err, 400)
+}
+
+func TestServer_templates(t *testing.T) {
+	_, client := testServer(t)
+	projectID := testCreateProject(t, client)
+
+	keys, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	noneKeyID := keys.Payload[0].ID
+	repository, err := client.Project.PostProjectProjectIDRepositories(&project.PostProjectProjectIDRepositoriesParams{ProjectID: projectID, Repository: &models.RepositoryRequest{
+		Name:     "Repository",
+		GitURL:   "https://example.com/repo.git",
+		SSHKeyID: noneKeyID,
+	}}, nil)
+	mustNot(t, err)
+	inventory, err := client.Project.PostProjectProjectIDInventory(&project.PostProjectProjectIDInventoryParams{ProjectID: projectID, Inventory: &models.InventoryRequest{
+		Name:     "Inventory",
+		Type:     "static",
+		SSHKeyID: noneKeyID,
+	}}, nil)
+	mustNot(t, err)
+	view, err := client.Project.PostProjectProjectIDViews(&project.PostProjectProjectIDViewsParams{ProjectID: projectID, View: &models.ViewRequest{Title: "View"}}, nil)
+	mustNot(t, err)
+
+	request := &models.TemplateRequest{
+		Name:         "Template",
+		Playbook:     "playbook.yml",
+		RepositoryID: repository.Payload.ID,
+		InventoryID:  inventory.Payload.ID + 100,
+		ViewID:       view.Payload.ID,
+		Arguments:    "[]",
+	}
+	_, err = client.Project.PostProjectProjectIDTemplates(&project.PostProjectProjectIDTemplatesParams{ProjectID: projectID, Template: request}, nil)
+	expectStatus(t, err, 400)
+
+	request.InventoryID = inventory.Payload.ID
+	template, err := client.Project.PostProjectProjectIDTemplates(&project.PostProjectProjectIDTemplatesParams{ProjectID: projectID, Template: request}, nil)
+	mustNot(t, err)
+	templateID := template.Payload.ID
+
+	_, err = client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{ProjectID: projectID, Schedule: &models.ScheduleRequest{
+		CronFormat: "not a cron",
+		TemplateID: templateID,
+	}}, nil)
+	expectStatus(t, err, 400)
+	scheduleResponse, err := client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{ProjectID: projectID, Schedule: &models.ScheduleRequest{
+		CronFormat: "* * * * *",
+		TemplateID: templateID,
+	}}, nil)
+	mustNot(t, err)
+
+	// run_at schedules run once, with the task params of the run
+	_, err = client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{ProjectID: projectID, Schedule: &models.ScheduleRequest{
+		Type:       models.ScheduleRequestTypeRunAt,
+		TemplateID: templateID,
+	}}, nil)
+	expectStatus(t, err, 400)
+	runAt := strfmt.DateTime(time.Date(2030, 1, 1, 2, 0, 0, 0, time.UTC))
+	runAtResponse, err := client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{ProjectID: projectID, Schedule: &models.ScheduleRequest{
+		Type:       models.ScheduleRequestTypeRunAt,
+		RunAt:      &runAt,
+		TemplateID: templateID,
+		TaskParams: &models.TaskPrams{GitBranch: "hotfix"},
+	}}, nil)
+	mustNot(t, err)
+	if runAtResponse.Payload.RunAt == nil || !time.Time(*runAtResponse.Payload.RunAt).Equal(time.Time(runAt)) || runAtResponse.Payload.TaskParams == nil || runAtResponse.Payload.TaskParams.GitBranch != "hotfix" {
+		t.Fatalf("run_at schedule does not match the request: %+v", runAtResponse.Payload)
+	}
+
+	// Deleting the view removes it from the template
+	_, err = client.Project.DeleteProjectProjectIDViewsViewID(&project.DeleteProjectProjectIDViewsViewIDParams{ProjectID: projectID, ViewID: view.Payload.ID}, nil)
+	mustNot(t, err)
+	response, err := client.Project.GetProjectProjectIDTemplatesTemplateID(&project.GetProjectProjectIDTemplatesTemplateIDParams{ProjectID: projectID, TemplateID: templateID}, nil)
+	mustNot(t, err)
+	if response.Payload.ViewID != 0 {
+		t.Fatalf("view was not removed from the template: %+v", response.Payload)
+	}
+
+	// The inventory is in use by the template, and schedules are deleted with the template
+	_, err = client.Project.DeleteProjectProjectIDInventoryInventoryID(&project.DeleteProjectProjectIDInventoryInventoryIDParams{ProjectID: projectID, InventoryID: inventory.Payload.ID}, nil)
+	expectStatus(t, err, 400)
+	_, err = client.Project.DeleteProjectProjectIDTemplatesTemplateID(&project.DeleteProjectProjectIDTemplatesTemplateIDParams{ProjectID: projectID, TemplateID: templateID}, nil)
+	mustNot(t, err)
+	_, err = client.Schedule.GetProjectProjectIDSchedulesScheduleID(&schedule.GetProjectProjectIDSchedulesScheduleIDParams{ProjectID: projectID, ScheduleID: scheduleResponse.Payload.ID}, nil)
+	expectStatus(t, err, 404)
+}
+
+func TestServer_users(t *testing.T) {
+	server, client := testServer(t)
+	projectID := testCreateProject(t, client)
+
+	request := &models.UserRequest{Username: "user", Name: "User", Email: "user@example.com", Password: "password"}
+	response, err := client.User.PostUsers(&user.PostUsersParams{User: request}, nil)
+	mustNot(t, err)
+	userID := response.Payload.ID
+
+	_, err = client.User.PostUsers(&user.PostUsersParams{User: request}, nil)
+	expectStatus(t, err, 400)
+
+	token, err := server.API.AddToken(userID)
+	mustNot(t, err)
+	userClient := testServerClient(t, server, token)
+
+	// Non-admin users can't manage users, or access projects they are not a member of
+	_, err = userClient.User.PostUsers(&user.PostUsersParams{User: &models.UserRequest{Username: "other", Name: "Other", Email: "other@example.com"}}, nil)
+	expectStatus(t, err, 403)
+	_, err = userClient.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: projectID}, nil)
+	expectStatus(t, err, 403)
+
+	_, err = client.Project.PostProjectProjectIDUsers(&project.PostProjectProjectIDUsersParams{ProjectID: projectID, User: project.PostProjectProjectIDUsersBody{UserID: userID, Role: "guest"}}, nil)
+	mustNot(t, err)
+	_, err = userClient.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	_, err = userClient.Project.PostProjectProjectIDViews(&project.PostProjectProjectIDViewsParams{ProjectID: projectID, View: &models.ViewRequest{Title: "View"}}, nil)
+	expectStatus(t, err, 403)
+
+	_, err = client.User.DeleteUsersUserID(&user.DeleteUsersUserIDParams{UserID: userID}, nil)
+	mustNot(t, err)
+	_, err = userClient.User.GetUser(&user.GetUserParams{}, nil)
+	expectStatus(t, err, 401)
+}
+
+// testRequest sends a request to the fake API with the admin token, decoding the response body into out when set,
+// and returns the status code. It is used for the endpoints the generated client does not have.
+func testRequest(t *testing.T, server *Server, method string, path string, out any) int {
+	t.Helper()
+	request, err := http.NewRequest(method, server.APIBaseURL()+path, nil)
+	mustNot(t, err)
+	request.Header.Set("Authorization", "Bearer "+server.API.AdminToken)
+	response, err := server.Client().Do(request)
+	mustNot(t, err)
+	defer response.Body.Close()
+	if out != nil && response.StatusCode == http.StatusOK {
+		mustNot(t, json.NewDecoder(response.Body).Decode(out))
+	}
+	return response.StatusCode
+}
+
+func TestServer_integrationAliases(t *testing.T) {
+	server, client := testServer(t)
+	projectID := testCreateProject(t, client)
+	aliases := fmt.Sprintf("/project/%d/integrations/aliases", projectID)
+
+	var alias struct {
+		ID  int64  `json:"id"`
+		URL string `json:"url"`
+	}
+	if code := testRequest(t, server, "POST", aliases, &alias); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if !strings.Contains(alias.URL, "/api/integrations/") {
+		t.Fatalf("unexpected alias URL %q", alias.URL)
+	}
+	if code := testRequest(t, server, "POST", fmt.Sprintf("/project/%d/integrations/100/aliases", projectID), nil); code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", code)
+	}
+
+	var list []map[string]any
+	testRequest(t, server, "GET", aliases, &list)
+	if len(list) != 1 {
+		t.Fatalf("expected 1 alias, got %v", list)
+	}
+	if code := testRequest(t, server, "DELETE", fmt.Sprintf("%s/%d", aliases, alias.ID), nil); code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", code)
+	}
+	testRequest(t, server, "GET", aliases, &list)
+	if len(list) != 0 {
+		t.Fatalf("expected no aliases, got %v", list)
+	}
+}
+
+func TestServer_apps(t *testing.T) {
+	server, client := testServer(t)
+
+	response, err := client.Operations.GetApps(&operations.GetAppsParams{}, nil)
+	mustNot(t, err)
+	apps := response.Payload
+	if len(apps) != len(defaultApps) || apps[0].ID != "ansible" || !apps[0].Active {
+		t.Fatalf("expected the default apps, ansible first, got %v", apps)
+	}
+
+	if !server.API.SetAppActive("bash", false) {
+		t.Fatal("expected the bash app to exist")
+	}
+	if server.API.SetAppActive("missing", false) {
+		t.Fatal("expected the missing app not to exist")
+	}
+
+	_, err = client.Operations.PutAppsAppID(&operations.PutAppsAppIDParams{AppID: "deno", App: &models.App{Title: "Deno", Path: "/usr/bin/deno", Priority: 10}}, nil)
+	mustNot(t, err)
+	_, err = client.Operations.PostAppsAppIDActive(&operations.PostAppsAppIDActiveParams{AppID: "deno", App: &models.AppActive{Active: true}}, nil)
+	mustNot(t, err)
+	app, err := client.Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: "deno"}, nil)
+	mustNot(t, err)
+	if app.Payload.Title != "Deno" || app.Payload.Path != "/usr/bin/deno" || !app.Payload.Active {
+		t.Fatalf("expected the active deno app, got %v", app.Payload)
+	}
+	_, err = client.Operations.PutAppsAppID(&operations.PutAppsAppIDParams{AppID: "Not Valid", App: &models.App{}}, nil)
+	expectStatus(t, err, 400)
+
+	// Removing an app removes its configuration, even for a default app.
+	_, err = client.Operations.DeleteAppsAppID(&operations.DeleteAppsAppIDParams{AppID: "bash"}, nil)
+	mustNot(t, err)
+	_, err = client.Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: "bash"}, nil)
+	expectStatus(t, err, 404)
+	_, err = client.Operations.DeleteAppsAppID(&operations.DeleteAppsAppIDParams{AppID: "deno"}, nil)
+	mustNot(t, err)
+	_, err = client.Operations.GetAppsAppID(&operations.GetAppsAppIDParams{AppID: "deno"}, nil)
+	expectStatus(t, err, 404)
+}
+
+func TestServer_taskVersions(t *testing.T) {
+	_, client := testServer(t)
+	projectID := testCreateProject(t, client)
+
+	keys, err := client.Project.GetProjectProjectIDKeys(&project.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+	mustNot(t, err)
+	repository, err := client.Project.PostProjectProjectIDRepositories(&project.PostProjectProjectIDRepositoriesParams{ProjectID: projectID, Repository: &models.RepositoryRequest{
+		Name:     "Repository",
+		GitURL:   "https://example.com/repo.git",
+		SSHKeyID: keys.Payload[0].ID,
+	}}, nil)
+	mustNot(t, err)
+	inventory, err := client.Project.PostProjectProjectIDInventory(&project.PostProjectProjectIDInventoryParams{ProjectID: projectID, Inventory: &models.InventoryRequest{
+		Name:     "Inventory",
+		Type:     "static",
+		SSHKeyID: keys.Payload[0].ID,
+	}}, nil)
+	mustNot(t, err)
+	createTemplate := func(request *models.TemplateRequest) int64 {
+		t.Helper()
+		request.Name = fmt.Sprintf("Template %s", request.Type)
+		request.Playbook = "playbook.yml"
+		request.RepositoryID = repository.Payload.ID
+		request.InventoryID = inventory.Payload.ID
+		request.Arguments = "[]"
+		response, err := client.Project.PostProjectProjectIDTemplates(&project.PostProjectProjectIDTemplatesParams{ProjectID: projectID, Template: request}, nil)
+		mustNot(t, err)
+		return response.Payload.ID
+	}
+	runTask := func(body *project.PostProjectProjectIDTasksBody) (*models.Task, error) {
+		t.Helper()
+		response, err := client.Project.PostProjectProjectIDTasks(&project.PostProjectProjectIDTasksParams{ProjectID: projectID, Task: *body}, nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	}
+	buildID := createTemplate(&models.TemplateRequest{Type: "build", StartVersion: "v1.9.0-rc"})
+	deployID := createTemplate(&models.TemplateRequest{Type: "deploy", BuildTemplateID: buildID})
+
+	// Deploy tasks need a build to deploy.
+	_, err = runTask(&project.PostProjectProjectIDTasksBody{TemplateID: deployID})
+	expectStatus(t, err, 400)
+
+	first, err := runTask(&project.PostProjectProjectIDTasksBody{TemplateID: buildID})
+	mustNot(t, err)
+	second, err := runTask(&project.PostProjectProjectIDTasksBody{TemplateID: buildID})
+	mustNot(t, err)
+	if first.Version != "v1.9.0-rc" || second.Version != "v1.9.1-rc" {
+		t.Fatalf("expected the build versions v1.9.0-rc and v1.9.1-rc, got %q and %q", first.Version, second.Version)
+	}
+
+	deploy, err := runTask(&project.PostProjectProjectIDTasksBody{TemplateID: deployID})
+	mustNot(t, err)
+	if deploy.BuildTaskID != second.ID || deploy.Version != second.Version {
+		t.Fatalf("expected the deploy of the last build, got %+v", deploy)
+	}
+	deploy, err = runTask(&project.PostProjectProjectIDTasksBody{TemplateID: deployID, BuildTaskID: first.ID})
+	mustNot(t, err)
+	if deploy.BuildTaskID != first.ID || deploy.Version != first.Version {
+		t.Fatalf("expected the deploy of the first build, got %+v", deploy)
+	}
+	_, err = runTask(&project.PostProjectProjectIDTasksBody{TemplateID: deployID, BuildTaskID: deploy.ID})
+	expectStatus(t, err, 400)
+}
@@ -0,0 +1,219 @@
+package semaphoretest
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// collectionTasks are the tasks of the project templates. The fake API queues tasks but never runs them, tasks stay
+// in the waiting status until they are stopped.
+var collectionTasks = &collection{
+	name:  "tasks",
+	title: "Task",
+	references: []reference{
+		{field: "template_id", collection: "templates", onDelete: cascade},
+		{field: "build_task_id", collection: "tasks", onDelete: setNull},
+	},
+	noRoutes: true,
+}
+
+// versionNumber matches the last number of a build version, the part that is incremented for the next build.
+var versionNumber = regexp.MustCompile(`[0-9]+([^0-9]*)$`)
+
+// nextVersion returns the version of the build following the version, by incrementing its last number.
+func nextVersion(version string) string {
+	match := versionNumber.FindStringSubmatchIndex(version)
+	if match == nil {
+		return version
+	}
+	number, err := strconv.ParseInt(version[match[0]:match[2]], 10, 64)
+	if err != nil {
+		return version
+	}
+	return version[:match[0]] + strconv.FormatInt(number+1, 10) + version[match[2]:]
+}
+
+// maxLastTasks is the number of tasks returned by the last tasks endpoint.
+const maxLastTasks = 200
+
+func (a *API) taskRoutes() {
+	a.handle("GET", "/project/{project_id}/tasks", accessProjectGuest, a.getTasks)
+	a.handle("GET", "/project/{project_id}/tasks/last", accessProjectGuest, a.getLastTasks)
+	a.handle("POST", "/project/{project_id}/tasks", accessProjectTaskRunner, a.createTask)
+	a.handle("GET", "/project/{project_id}/tasks/{object_id}", accessProjectGuest, a.getTask)
+	a.handle("DELETE", "/project/{project_id}/tasks/{object_id}", accessProjectManager, a.deleteTask)
+	a.handle("POST", "/project/{project_id}/tasks/{object_id}/stop", accessProjectTaskRunner, a.stopTask)
+	a.handle("GET", "/project/{project_id}/tasks/{object_id}/output", accessProjectGuest, a.getTaskOutput)
+	a.handle("GET", "/project/{project_id}/tasks/{object_id}/raw_output", accessProjectGuest, a.getTaskRawOutput)
+	a.handle("POST", "/project/{project_id}/templates/{object_id}/stop_all_tasks", accessProjectTaskRunner, a.stopAllTasks)
+	a.handle("GET", "/project/{project_id}/templates/{object_id}/schedules", accessProjectGuest, a.getTemplateSchedules)
+}
+
+// projectTasks returns the tasks of the project, the most recent first.
+func (a *API) projectTasks(c *call) []object {
+	tasks := make([]object, 0)
+	for _, task := range sortedByID(a.collections[collectionTasks.name]) {
+		if task.Int("project_id") == c.ProjectID() {
+			tasks = append(tasks, task.Clone())
+		}
+	}
+	slices.Reverse(tasks)
+	return tasks
+}
+
+func (a *API) getTasks(c *call) (int, any, error) {
+	return http.StatusOK, a.projectTasks(c), nil
+}
+
+func (a *API) getLastTasks(c *call) (int, any, error) {
+	tasks := a.projectTasks(c)
+	if len(tasks) > maxLastTasks {
+		tasks = tasks[:maxLastTasks]
+	}
+	return http.StatusOK, tasks, nil
+}
+
+func (a *API) createTask(c *call) (int, any, error) {
+	task, err := a.requestObject(c, collectionTasks, 0, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	template := a.collections[collectionTemplates.name][task.Int("template_id")]
+	if template == nil || template.Int("project_id") != c.ProjectID() {
+		return 0, nil, badRequest("Task template_id %d does not exist in the project", task.Int("template_id"))
+	}
+	if inventoryID := task.Int("inventory_id"); inventoryID != 0 && !a.exists(collectionInventory.name, c.ProjectID(), inventoryID) {
+		return 0, nil, badRequest("Task inventory_id %d does not exist in the project", inventoryID)
+	}
+
+	if err := a.setTaskVersion(template, task); err != nil {
+		return 0, nil, err
+	}
+
+	task["status"] = "waiting"
+	task["user_id"] = c.user.Int("id")
+	task["created"] = now()
+	a.insert(collectionTasks, task)
+	return http.StatusCreated, task.Clone(), nil
+}
+
+// lastTask returns the most recent task of the template, or nil when the template has no task.
+func (a *API) lastTask(templateID int64) object {
+	var last object
+	for _, task := range a.collections[collectionTasks.name] {
+		if task.Int("template_id") == templateID && (last == nil || task.Int("id") > last.Int("id")) {
+			last = task
+		}
+	}
+	return last
+}
+
+// setTaskVersion sets the version of build and deploy template tasks. Build tasks get the start version of the
+// template, or the next version of the previous build. Deploy tasks deploy the version of a build task of the build
+// template, the most recent one unless the request selects one.
+func (a *API) setTaskVersion(template object, task object) error {
+	switch template.String("type") {
+	case "build":
+		delete(task, "build_task_id")
+		task["version"] = template.String("start_version")
+		if last := a.lastTask(template.Int("id")); last != nil {
+			task["version"] = nextVersion(last.String("version"))
+		}
+	case "deploy":
+		build := a.lastTask(template.Int("build_template_id"))
+		if buildTaskID := task.Int("build_task_id"); buildTaskID != 0 {
+			build = a.collections[collectionTasks.name][buildTaskID]
+			if build == nil || build.Int("template_id") != template.Int("build_template_id") {
+				return badRequest("Task build_task_id %d is not a task of the build template", buildTaskID)
+			}
+		}
+		if build == nil {
+			return badRequest("The build template of the deploy template has no task")
+		}
+		task["build_task_id"] = build.Int("id")
+		task["version"] = build.String("version")
+	default:
+		delete(task, "build_task_id")
+		delete(task, "version")
+	}
+	return nil
+}
+
+func (a *API) getTask(c *call) (int, any, error) {
+	task, err := a.findObject(c, collectionTasks)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, task.Clone(), nil
+}
+
+func (a *API) deleteTask(c *call) (int, any, error) {
+	task, err := a.findObject(c, collectionTasks)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := a.remove(collectionTasks, task); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// stop stops a task that has not finished yet.
+func stop(task object) {
+	if slices.Contains([]string{"waiting", "starting", "running"}, task.String("status")) {
+		task["status"] = "stopped"
+		task["end"] = now()
+	}
+}
+
+func (a *API) stopTask(c *call) (int, any, error) {
+	task, err := a.findObject(c, collectionTasks)
+	if err != nil {
+		return 0, nil, err
+	}
+	stop(task)
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) getTaskOutput(c *call) (int, any, error) {
+	if _, err := a.findObject(c, collectionTasks); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, []object{}, nil
+}
+
+func (a *API) getTaskRawOutput(c *call) (int, any, error) {
+	if _, err := a.findObject(c, collectionTasks); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, "", nil
+}
+
+func (a *API) stopAllTasks(c *call) (int, any, error) {
+	template, err := a.findObject(c, collectionTemplates)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, task := range a.collections[collectionTasks.name] {
+		if task.Int("template_id") == template.Int("id") {
+			stop(task)
+		}
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) getTemplateSchedules(c *call) (int, any, error) {
+	template, err := a.findObject(c, collectionTemplates)
+	if err != nil {
+		return 0, nil, err
+	}
+	schedules := make([]object, 0)
+	for _, schedule := range sortedByID(a.collections[collectionSchedules.name]) {
+		if schedule.Int("template_id") == template.Int("id") {
+			schedules = append(schedules, schedule.Clone())
+		}
+	}
+	return http.StatusOK, schedules, nil
+}
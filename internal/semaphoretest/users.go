@@ -0,0 +1,199 @@
+package semaphoretest
+
+import (
+	"net/http"
+	"sort"
+)
+
+// userFields are the fields of a user that can be set through the API.
+var userFields = []string{"username", "name", "email", "admin", "alert"}
+
+func (a *API) userRoutes() {
+	a.handle("GET", "/user/{$}", accessUser, a.getCurrentUser)
+	a.handle("GET", "/user", accessUser, a.getCurrentUser)
+	a.handle("GET", "/user/tokens", accessUser, a.getTokens)
+	a.handle("POST", "/user/tokens", accessUser, a.createToken)
+	a.handle("DELETE", "/user/tokens/{api_token_id}", accessUser, a.deleteToken)
+
+	a.handle("GET", "/users", accessUser, a.getUsers)
+	a.handle("POST", "/users", accessAdmin, a.createUser)
+	for _, pattern := range []string{"/users/{user_id}/{$}", "/users/{user_id}"} {
+		a.handle("GET", pattern, accessUser, a.getUser)
+		a.handle("PUT", pattern, accessUser, a.updateUser)
+		a.handle("DELETE", pattern, accessAdmin, a.deleteUser)
+	}
+	a.handle("POST", "/users/{user_id}/password", accessUser, a.updateUserPassword)
+}
+
+func (a *API) getCurrentUser(c *call) (int, any, error) {
+	return http.StatusOK, c.user.Clone(), nil
+}
+
+func (a *API) getTokens(c *call) (int, any, error) {
+	tokens := make([]object, 0)
+	for _, token := range a.tokens {
+		if token.Int("user_id") == c.user.Int("id") {
+			tokens = append(tokens, token.Clone())
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].String("created") < tokens[j].String("created") })
+	return http.StatusOK, tokens, nil
+}
+
+func (a *API) createToken(c *call) (int, any, error) {
+	token := a.addToken(c.user.Int("id"))
+	return http.StatusCreated, a.tokens[token].Clone(), nil
+}
+
+func (a *API) deleteToken(c *call) (int, any, error) {
+	token := a.tokens[c.r.PathValue("api_token_id")]
+	if token == nil || token.Int("user_id") != c.user.Int("id") {
+		return 0, nil, notFound("API token")
+	}
+	token["expired"] = true
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) getUsers(c *call) (int, any, error) {
+	users := make([]object, 0, len(a.users))
+	for _, user := range sortedByID(a.users) {
+		users = append(users, user.Clone())
+	}
+	return http.StatusOK, users, nil
+}
+
+// findUser returns the user of the user_id path parameter.
+func (a *API) findUser(c *call) (object, error) {
+	id, err := c.PathID("user_id")
+	if err != nil {
+		return nil, err
+	}
+	user := a.users[id]
+	if user == nil {
+		return nil, notFound("User")
+	}
+	return user, nil
+}
+
+// validateUser checks the required fields of a user and that the username and email are unique.
+func (a *API) validateUser(user object, id int64) error {
+	for _, field := range []string{"username", "name", "email"} {
+		if user.String(field) == "" {
+			return badRequest("User %s can not be empty", field)
+		}
+	}
+	for _, other := range a.users {
+		if other.Int("id") == id {
+			continue
+		}
+		if other.String("username") == user.String("username") {
+			return badRequest("User with username %s already exists", user.String("username"))
+		}
+		if other.String("email") == user.String("email") {
+			return badRequest("User with email %s already exists", user.String("email"))
+		}
+	}
+	return nil
+}
+
+func (a *API) createUser(c *call) (int, any, error) {
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := a.validateUser(body, 0); err != nil {
+		return 0, nil, err
+	}
+
+	user := object{
+		"external": body.Bool("external"),
+		"created":  now(),
+	}
+	for _, field := range userFields {
+		user[field] = body[field]
+	}
+	user["admin"], user["alert"] = body.Bool("admin"), body.Bool("alert")
+	user["id"] = a.nextID("users")
+	a.users[user.Int("id")] = user
+	a.passwords[user.Int("id")] = body.String("password")
+	return http.StatusCreated, user.Clone(), nil
+}
+
+func (a *API) getUser(c *call) (int, any, error) {
+	user, err := a.findUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, user.Clone(), nil
+}
+
+func (a *API) updateUser(c *call) (int, any, error) {
+	user, err := a.findUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	admin := c.user.Bool("admin")
+	if !admin && user.Int("id") != c.user.Int("id") {
+		return 0, nil, forbidden()
+	}
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if !admin && body.Bool("admin") {
+		return 0, nil, forbidden()
+	}
+	if err := a.validateUser(body, user.Int("id")); err != nil {
+		return 0, nil, err
+	}
+	if user.Bool("external") && (body.String("username") != user.String("username") || body.String("email") != user.String("email")) {
+		return 0, nil, badRequest("Username and email of external users can not be changed")
+	}
+
+	for _, field := range userFields {
+		user[field] = body[field]
+	}
+	user["admin"], user["alert"] = body.Bool("admin"), body.Bool("alert")
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) deleteUser(c *call) (int, any, error) {
+	user, err := a.findUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	id := user.Int("id")
+	delete(a.users, id)
+	delete(a.passwords, id)
+	for _, members := range a.members {
+		delete(members, id)
+	}
+	for token, details := range a.tokens {
+		if details.Int("user_id") == id {
+			delete(a.tokens, token)
+		}
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *API) updateUserPassword(c *call) (int, any, error) {
+	user, err := a.findUser(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !c.user.Bool("admin") && user.Int("id") != c.user.Int("id") {
+		return 0, nil, forbidden()
+	}
+	if user.Bool("external") {
+		return 0, nil, badRequest("Password of external users can not be changed")
+	}
+	body, err := c.Body()
+	if err != nil {
+		return 0, nil, err
+	}
+	if body.String("password") == "" {
+		return 0, nil, badRequest("Password can not be empty")
+	}
+	a.passwords[user.Int("id")] = body.String("password")
+	return http.StatusNoContent, nil, nil
+}
@@ -0,0 +1,41 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = DurationValidator{}
+
+type DurationValidator struct{}
+
+func (v DurationValidator) Description(ctx context.Context) string {
+	return ""
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a positive [Go duration](https://pkg.go.dev/time#ParseDuration), for example `720h`."
+}
+
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%s must be a positive duration such as 24h or 720h, got %s", req.Path, req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}
+
+func Duration() DurationValidator {
+	return DurationValidator{}
+}
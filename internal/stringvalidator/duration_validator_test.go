@@ -0,0 +1,39 @@
+package stringvalidator
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestDurationValidator(t *testing.T) {
+	tests := map[string]struct {
+		value     types.String
+		expectErr bool
+	}{
+		"hours":    {value: types.StringValue("720h")},
+		"mixed":    {value: types.StringValue("1h30m")},
+		"zero":     {value: types.StringValue("0s"), expectErr: true},
+		"negative": {value: types.StringValue("-1h"), expectErr: true},
+		"days":     {value: types.StringValue("30d"), expectErr: true},
+		"empty":    {value: types.StringValue(""), expectErr: true},
+		"unknown":  {value: types.StringUnknown()},
+		"null":     {value: types.StringNull()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.value,
+			}
+			response := validator.StringResponse{}
+			Duration().ValidateString(context.Background(), request, &response)
+			if response.Diagnostics.HasError() != test.expectErr {
+				t.Fatalf("expected error %v, got diagnostics %v", test.expectErr, response.Diagnostics)
+			}
+		})
+	}
+}
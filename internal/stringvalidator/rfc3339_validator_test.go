@@ -0,0 +1,38 @@
+package stringvalidator
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestRFC3339Validator(t *testing.T) {
+	tests := map[string]struct {
+		value     types.String
+		expectErr bool
+	}{
+		"utc":       {value: types.StringValue("2025-01-31T22:00:00Z")},
+		"offset":    {value: types.StringValue("2025-01-31T23:00:00+01:00")},
+		"date":      {value: types.StringValue("2025-01-31"), expectErr: true},
+		"no offset": {value: types.StringValue("2025-01-31T22:00:00"), expectErr: true},
+		"garbage":   {value: types.StringValue("tomorrow"), expectErr: true},
+		"unknown":   {value: types.StringUnknown()},
+		"null":      {value: types.StringNull()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.value,
+			}
+			response := validator.StringResponse{}
+			RFC3339().ValidateString(context.Background(), request, &response)
+			if response.Diagnostics.HasError() != test.expectErr {
+				t.Fatalf("expected error %v, got diagnostics %v", test.expectErr, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package stringvalidator
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+	"strings"
+)
+
+var _ validator.String = SSHPrivateKeyValidator{}
+
+type SSHPrivateKeyValidator struct {
+	Passphrase path.Expression
+}
+
+func (v SSHPrivateKeyValidator) Description(ctx context.Context) string {
+	return ""
+}
+
+func (v SSHPrivateKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a PEM or OpenSSH encoded private key that can be decrypted with the passphrase."
+}
+
+func (v SSHPrivateKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	passphrase := types.StringNull()
+	matchedPaths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(v.Passphrase))
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	for _, matchedPath := range matchedPaths {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, matchedPath, &passphrase)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// The passphrase is not known yet, so the key can not be decrypted.
+	if passphrase.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseSSHPrivateKey(req.ConfigValue.ValueString(), passphrase.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH private key",
+			fmt.Sprintf("%s must be a valid SSH private key: %s", req.Path, err),
+		)
+		return
+	}
+}
+
+// ParseSSHPrivateKey parses a PEM or OpenSSH encoded private key, decrypting it with the passphrase if it is
+// not empty. Public keys are rejected with a dedicated error as they are a common mistake.
+func ParseSSHPrivateKey(privateKey string, passphrase string) (ssh.Signer, error) {
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(privateKey)); err == nil {
+		return nil, errors.New("a public key was supplied instead of the private key")
+	}
+	if strings.Contains(privateKey, "PUBLIC KEY-----") {
+		return nil, errors.New("a public key was supplied instead of the private key")
+	}
+
+	if passphrase == "" {
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		var missingErr *ssh.PassphraseMissingError
+		if errors.As(err, &missingErr) {
+			return nil, errors.New("the private key is encrypted but no passphrase was supplied")
+		}
+		return signer, err
+	}
+
+	signer, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	if err != nil {
+		// The passphrase is ignored when the private key is not encrypted
+		if plainSigner, plainErr := ssh.ParsePrivateKey([]byte(privateKey)); plainErr == nil {
+			return plainSigner, nil
+		}
+		if errors.Is(err, x509.IncorrectPasswordError) {
+			return nil, errors.New("the passphrase does not decrypt the private key")
+		}
+		return nil, err
+	}
+	return signer, nil
+}
+
+// SSHPrivateKey validates that the value is an SSH private key that can be decrypted with the passphrase
+// attribute at the given path expression, which is usually relative to the validated attribute.
+func SSHPrivateKey(passphrase path.Expression) SSHPrivateKeyValidator {
+	return SSHPrivateKeyValidator{
+		Passphrase: passphrase,
+	}
+}
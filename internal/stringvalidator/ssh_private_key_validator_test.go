@@ -0,0 +1,156 @@
+package stringvalidator
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/crypto/ssh"
+	"strings"
+	"testing"
+)
+
+type testSSHKeys struct {
+	plain      string
+	encrypted  string
+	pemRSA     string
+	public     string
+	pemPublic  string
+	passphrase string
+}
+
+func newTestSSHKeys(t *testing.T) testSSHKeys {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := testSSHKeys{passphrase: "secret"}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.plain = string(pem.EncodeToMemory(block))
+	block, err = ssh.MarshalPrivateKeyWithPassphrase(privateKey, "", []byte(keys.passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.encrypted = string(pem.EncodeToMemory(block))
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.public = string(ssh.MarshalAuthorizedKey(sshPublicKey))
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.pemPublic = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.pemRSA = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	return keys
+}
+
+func TestParseSSHPrivateKey(t *testing.T) {
+	keys := newTestSSHKeys(t)
+	tests := map[string]struct {
+		privateKey string
+		passphrase string
+		err        string
+	}{
+		"unencrypted":                   {privateKey: keys.plain},
+		"unencrypted with a passphrase": {privateKey: keys.plain, passphrase: "ignored"},
+		"pem rsa":                       {privateKey: keys.pemRSA},
+		"encrypted":                     {privateKey: keys.encrypted, passphrase: keys.passphrase},
+		"encrypted without passphrase":  {privateKey: keys.encrypted, err: "no passphrase was supplied"},
+		"encrypted wrong passphrase":    {privateKey: keys.encrypted, passphrase: "wrong", err: "passphrase"},
+		"public key":                    {privateKey: keys.public, err: "a public key was supplied"},
+		"pasted public key":             {privateKey: strings.TrimSpace(keys.public) + " user@host", err: "a public key was supplied"},
+		"pem public key":                {privateKey: keys.pemPublic, err: "a public key was supplied"},
+		"garbage":                       {privateKey: "not a key", err: "no key found"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			signer, err := ParseSSHPrivateKey(test.privateKey, test.passphrase)
+			if test.err == "" {
+				if err != nil || signer == nil {
+					t.Fatalf("expected a signer, got error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestSSHPrivateKeyValidator(t *testing.T) {
+	keys := newTestSSHKeys(t)
+	ctx := context.Background()
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"private_key": schema.StringAttribute{Optional: true},
+			"passphrase":  schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"private_key": tftypes.String,
+		"passphrase":  tftypes.String,
+	}}
+
+	tests := map[string]struct {
+		privateKey types.String
+		passphrase tftypes.Value
+		expectErr  bool
+	}{
+		"unencrypted":                  {privateKey: types.StringValue(keys.plain), passphrase: tftypes.NewValue(tftypes.String, nil)},
+		"encrypted":                    {privateKey: types.StringValue(keys.encrypted), passphrase: tftypes.NewValue(tftypes.String, keys.passphrase)},
+		"encrypted without passphrase": {privateKey: types.StringValue(keys.encrypted), passphrase: tftypes.NewValue(tftypes.String, nil), expectErr: true},
+		"encrypted wrong passphrase":   {privateKey: types.StringValue(keys.encrypted), passphrase: tftypes.NewValue(tftypes.String, "wrong"), expectErr: true},
+		"unknown passphrase":           {privateKey: types.StringValue(keys.encrypted), passphrase: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"public key":                   {privateKey: types.StringValue(keys.public), passphrase: tftypes.NewValue(tftypes.String, nil), expectErr: true},
+		"garbage":                      {privateKey: types.StringValue("not a key"), passphrase: tftypes.NewValue(tftypes.String, nil), expectErr: true},
+		"unknown":                      {privateKey: types.StringUnknown(), passphrase: tftypes.NewValue(tftypes.String, nil)},
+		"null":                         {privateKey: types.StringNull(), passphrase: tftypes.NewValue(tftypes.String, nil)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			privateKey, err := test.privateKey.ToTerraformValue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			request := validator.StringRequest{
+				Path:           path.Root("private_key"),
+				PathExpression: path.MatchRoot("private_key"),
+				ConfigValue:    test.privateKey,
+				Config: tfsdk.Config{
+					Schema: configSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"private_key": privateKey,
+						"passphrase":  test.passphrase,
+					}),
+				},
+			}
+			response := validator.StringResponse{}
+			SSHPrivateKey(path.MatchRelative().AtParent().AtName("passphrase")).ValidateString(ctx, request, &response)
+			if response.Diagnostics.HasError() != test.expectErr {
+				t.Fatalf("expected error %v, got diagnostics %v", test.expectErr, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+	// Embed the IANA time zone database, the provider can run on systems without it.
+	_ "time/tzdata"
+)
+
+var _ validator.String = TimezoneValidator{}
+
+type TimezoneValidator struct{}
+
+func (v TimezoneValidator) Description(ctx context.Context) string {
+	return ""
+}
+
+func (v TimezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a time zone of the [IANA Time Zone database](https://www.iana.org/time-zones), for example `Europe/Paris`."
+}
+
+func (v TimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	// time.LoadLocation accepts "" and "Local", which are not IANA time zones
+	value := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid timezone",
+			fmt.Sprintf("%s must be an IANA time zone such as UTC or Europe/Paris, got %s", req.Path, value),
+		)
+		return
+	}
+}
+
+func Timezone() TimezoneValidator {
+	return TimezoneValidator{}
+}
@@ -0,0 +1,38 @@
+package stringvalidator
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestTimezoneValidator(t *testing.T) {
+	tests := map[string]struct {
+		value     types.String
+		expectErr bool
+	}{
+		"utc":     {value: types.StringValue("UTC")},
+		"iana":    {value: types.StringValue("Europe/Paris")},
+		"local":   {value: types.StringValue("Local"), expectErr: true},
+		"empty":   {value: types.StringValue(""), expectErr: true},
+		"garbage": {value: types.StringValue("Mars/Olympus"), expectErr: true},
+		"unknown": {value: types.StringUnknown()},
+		"null":    {value: types.StringNull()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.value,
+			}
+			response := validator.StringResponse{}
+			Timezone().ValidateString(context.Background(), request, &response)
+			if response.Diagnostics.HasError() != test.expectErr {
+				t.Fatalf("expected error %v, got diagnostics %v", test.expectErr, response.Diagnostics)
+			}
+		})
+	}
+}
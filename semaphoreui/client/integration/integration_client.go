@@ -80,14 +80,26 @@ func WithAcceptTextPlainCharsetUTF8(r *runtime.ClientOperation) {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeleteProjectProjectIDIntegrationsAliasesAliasID(params *DeleteProjectProjectIDIntegrationsAliasesAliasIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsAliasesAliasIDNoContent, error)
+
+	DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID(params *DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDNoContent, error)
+
 	DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID(params *DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDNoContent, error)
 
 	DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueID(params *DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDNoContent, error)
 
+	GetProjectProjectIDIntegrationsAliases(params *GetProjectProjectIDIntegrationsAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsAliasesOK, error)
+
+	GetProjectProjectIDIntegrationsIntegrationIDAliases(params *GetProjectProjectIDIntegrationsIntegrationIDAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsIntegrationIDAliasesOK, error)
+
 	GetProjectProjectIDIntegrationsIntegrationIDMatchers(params *GetProjectProjectIDIntegrationsIntegrationIDMatchersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsIntegrationIDMatchersOK, error)
 
 	GetProjectProjectIDIntegrationsIntegrationIDValues(params *GetProjectProjectIDIntegrationsIntegrationIDValuesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsIntegrationIDValuesOK, error)
 
+	PostProjectProjectIDIntegrationsAliases(params *PostProjectProjectIDIntegrationsAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDIntegrationsAliasesOK, error)
+
+	PostProjectProjectIDIntegrationsIntegrationIDAliases(params *PostProjectProjectIDIntegrationsIntegrationIDAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDIntegrationsIntegrationIDAliasesOK, error)
+
 	PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID(params *PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDNoContent, error)
 
 	PutProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueID(params *PutProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDNoContent, error)
@@ -95,6 +107,84 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DeleteProjectProjectIDIntegrationsAliasesAliasID removes project integration alias
+*/
+func (a *Client) DeleteProjectProjectIDIntegrationsAliasesAliasID(params *DeleteProjectProjectIDIntegrationsAliasesAliasIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsAliasesAliasIDNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteProjectProjectIDIntegrationsAliasesAliasIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteProjectProjectIDIntegrationsAliasesAliasID",
+		Method:             "DELETE",
+		PathPattern:        "/project/{project_id}/integrations/aliases/{alias_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteProjectProjectIDIntegrationsAliasesAliasIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteProjectProjectIDIntegrationsAliasesAliasIDNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteProjectProjectIDIntegrationsAliasesAliasID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID removes integration alias
+*/
+func (a *Client) DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID(params *DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID",
+		Method:             "DELETE",
+		PathPattern:        "/project/{project_id}/integrations/{integration_id}/aliases/{alias_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasIDNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteProjectProjectIDIntegrationsIntegrationIDAliasesAliasID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID removes integration matcher
 */
@@ -173,6 +263,84 @@ func (a *Client) DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractval
 	panic(msg)
 }
 
+/*
+GetProjectProjectIDIntegrationsAliases gets project integration aliases
+*/
+func (a *Client) GetProjectProjectIDIntegrationsAliases(params *GetProjectProjectIDIntegrationsAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsAliasesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectProjectIDIntegrationsAliasesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDIntegrationsAliases",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/integrations/aliases",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDIntegrationsAliasesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectProjectIDIntegrationsAliasesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDIntegrationsAliases: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetProjectProjectIDIntegrationsIntegrationIDAliases gets integration aliases
+*/
+func (a *Client) GetProjectProjectIDIntegrationsIntegrationIDAliases(params *GetProjectProjectIDIntegrationsIntegrationIDAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDIntegrationsIntegrationIDAliasesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectProjectIDIntegrationsIntegrationIDAliasesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDIntegrationsIntegrationIDAliases",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/integrations/{integration_id}/aliases",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDIntegrationsIntegrationIDAliasesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectProjectIDIntegrationsIntegrationIDAliasesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDIntegrationsIntegrationIDAliases: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetProjectProjectIDIntegrationsIntegrationIDMatchers gets integration matcher linked to integration extractor
 */
@@ -251,6 +419,84 @@ func (a *Client) GetProjectProjectIDIntegrationsIntegrationIDValues(params *GetP
 	panic(msg)
 }
 
+/*
+PostProjectProjectIDIntegrationsAliases adds project integration alias
+*/
+func (a *Client) PostProjectProjectIDIntegrationsAliases(params *PostProjectProjectIDIntegrationsAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDIntegrationsAliasesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProjectProjectIDIntegrationsAliasesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostProjectProjectIDIntegrationsAliases",
+		Method:             "POST",
+		PathPattern:        "/project/{project_id}/integrations/aliases",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PostProjectProjectIDIntegrationsAliasesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostProjectProjectIDIntegrationsAliasesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostProjectProjectIDIntegrationsAliases: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PostProjectProjectIDIntegrationsIntegrationIDAliases adds integration alias
+*/
+func (a *Client) PostProjectProjectIDIntegrationsIntegrationIDAliases(params *PostProjectProjectIDIntegrationsIntegrationIDAliasesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDIntegrationsIntegrationIDAliasesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProjectProjectIDIntegrationsIntegrationIDAliasesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostProjectProjectIDIntegrationsIntegrationIDAliases",
+		Method:             "POST",
+		PathPattern:        "/project/{project_id}/integrations/{integration_id}/aliases",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PostProjectProjectIDIntegrationsIntegrationIDAliasesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostProjectProjectIDIntegrationsIntegrationIDAliasesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostProjectProjectIDIntegrationsIntegrationIDAliases: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID updates integration matcher
 */
@@ -46,7 +46,7 @@ GetAppsOK describes a response with status code 200, with default header values.
 Apps
 */
 type GetAppsOK struct {
-	Payload []models.App
+	Payload []*models.App
 }
 
 // IsSuccess returns true when this get apps o k response has a 2xx status code
@@ -89,7 +89,7 @@ func (o *GetAppsOK) String() string {
 	return fmt.Sprintf("[GET /apps][%d] getAppsOK %s", 200, payload)
 }
 
-func (o *GetAppsOK) GetPayload() []models.App {
+func (o *GetAppsOK) GetPayload() []*models.App {
 	return o.Payload
 }
 
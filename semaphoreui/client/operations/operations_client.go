@@ -85,8 +85,12 @@ func WithAcceptTextPlainCharsetUTF8(r *runtime.ClientOperation) {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeleteAppsAppID(params *DeleteAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteAppsAppIDNoContent, error)
+
 	GetApps(params *GetAppsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAppsOK, error)
 
+	GetAppsAppID(params *GetAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAppsAppIDOK, error)
+
 	GetEvents(params *GetEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetEventsOK, error)
 
 	GetEventsLast(params *GetEventsLastParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetEventsLastOK, error)
@@ -97,11 +101,54 @@ type ClientService interface {
 
 	GetWs(params *GetWsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetWsOK, error)
 
+	PostAppsAppIDActive(params *PostAppsAppIDActiveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostAppsAppIDActiveNoContent, error)
+
 	PostDebugGc(params *PostDebugGcParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostDebugGcNoContent, error)
 
+	PutAppsAppID(params *PutAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutAppsAppIDNoContent, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DeleteAppsAppID removes app
+*/
+func (a *Client) DeleteAppsAppID(params *DeleteAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteAppsAppIDNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteAppsAppIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteAppsAppID",
+		Method:             "DELETE",
+		PathPattern:        "/apps/{app_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteAppsAppIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteAppsAppIDNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteAppsAppID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetApps gets apps
 */
@@ -141,6 +188,45 @@ func (a *Client) GetApps(params *GetAppsParams, authInfo runtime.ClientAuthInfoW
 	panic(msg)
 }
 
+/*
+GetAppsAppID gets app
+*/
+func (a *Client) GetAppsAppID(params *GetAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAppsAppIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAppsAppIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetAppsAppID",
+		Method:             "GET",
+		PathPattern:        "/apps/{app_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetAppsAppIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAppsAppIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetAppsAppID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetEvents gets events related to semaphore and projects you are part of
 */
@@ -337,6 +423,45 @@ func (a *Client) GetWs(params *GetWsParams, authInfo runtime.ClientAuthInfoWrite
 	panic(msg)
 }
 
+/*
+PostAppsAppIDActive activates or deactivates app
+*/
+func (a *Client) PostAppsAppIDActive(params *PostAppsAppIDActiveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostAppsAppIDActiveNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAppsAppIDActiveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostAppsAppIDActive",
+		Method:             "POST",
+		PathPattern:        "/apps/{app_id}/active",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PostAppsAppIDActiveReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostAppsAppIDActiveNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostAppsAppIDActive: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostDebugGc garbages collector
 
@@ -378,6 +503,45 @@ func (a *Client) PostDebugGc(params *PostDebugGcParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+PutAppsAppID updates app
+*/
+func (a *Client) PutAppsAppID(params *PutAppsAppIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutAppsAppIDNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutAppsAppIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutAppsAppID",
+		Method:             "PUT",
+		PathPattern:        "/apps/{app_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PutAppsAppIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutAppsAppIDNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PutAppsAppID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -113,6 +113,9 @@ swagger:model PostProjectProjectIDTasksBody
 */
 type PostProjectProjectIDTasksBody struct {
 
+	// build task id
+	BuildTaskID int64 `json:"build_task_id,omitempty"`
+
 	// debug
 	Debug bool `json:"debug,omitempty"`
 
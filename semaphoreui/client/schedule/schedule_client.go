@@ -84,6 +84,8 @@ type ClientService interface {
 
 	GetProjectProjectIDSchedulesScheduleID(params *GetProjectProjectIDSchedulesScheduleIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDSchedulesScheduleIDOK, error)
 
+	GetProjectProjectIDTemplatesTemplateIDSchedules(params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error)
+
 	PostProjectProjectIDSchedules(params *PostProjectProjectIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDSchedulesCreated, error)
 
 	PutProjectProjectIDSchedulesScheduleID(params *PutProjectProjectIDSchedulesScheduleIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDSchedulesScheduleIDNoContent, error)
@@ -169,6 +171,45 @@ func (a *Client) GetProjectProjectIDSchedulesScheduleID(params *GetProjectProjec
 	panic(msg)
 }
 
+/*
+GetProjectProjectIDTemplatesTemplateIDSchedules gets template schedules
+*/
+func (a *Client) GetProjectProjectIDTemplatesTemplateIDSchedules(params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectProjectIDTemplatesTemplateIDSchedulesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDTemplatesTemplateIDSchedules",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/templates/{template_id}/schedules",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDTemplatesTemplateIDSchedulesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectProjectIDTemplatesTemplateIDSchedulesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDTemplatesTemplateIDSchedules: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostProjectProjectIDSchedules creates schedule
 */
@@ -5,7 +5,73 @@ package models
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
 
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
 // App app
 //
 // swagger:model App
-type App interface{}
+type App struct {
+
+	// active
+	Active bool `json:"active,omitempty"`
+
+	// color
+	// Example: black
+	Color string `json:"color,omitempty"`
+
+	// dark color
+	// Example: white
+	DarkColor string `json:"dark_color,omitempty"`
+
+	// icon
+	// Example: mdi-ansible
+	Icon string `json:"icon,omitempty"`
+
+	// id
+	// Example: ansible
+	ID string `json:"id,omitempty"`
+
+	// path
+	// Example: /usr/bin/ansible-playbook
+	Path string `json:"path,omitempty"`
+
+	// priority
+	Priority int64 `json:"priority,omitempty"`
+
+	// title
+	// Example: Ansible
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this app
+func (m *App) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this app based on context it is used
+func (m *App) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *App) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *App) UnmarshalBinary(b []byte) error {
+	var res App
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
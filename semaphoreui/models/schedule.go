@@ -7,9 +7,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Schedule schedule
@@ -32,17 +35,148 @@ type Schedule struct {
 	// project id
 	ProjectID int64 `json:"project_id,omitempty"`
 
+	// run at
+	// Format: date-time
+	RunAt *strfmt.DateTime `json:"run_at,omitempty"`
+
+	// task params
+	TaskParams *TaskPrams `json:"task_params,omitempty"`
+
 	// template id
 	TemplateID int64 `json:"template_id,omitempty"`
+
+	// type
+	// Enum: ["","run_at"]
+	Type string `json:"type,omitempty"`
 }
 
 // Validate validates this schedule
 func (m *Schedule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRunAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaskParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Schedule) validateRunAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.RunAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("run_at", "body", "date-time", m.RunAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Schedule) validateTaskParams(formats strfmt.Registry) error {
+	if swag.IsZero(m.TaskParams) { // not required
+		return nil
+	}
+
+	if m.TaskParams != nil {
+		if err := m.TaskParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var scheduleTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["","run_at"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		scheduleTypeTypePropEnum = append(scheduleTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ScheduleTypeEmpty captures enum value ""
+	ScheduleTypeEmpty string = ""
+
+	// ScheduleTypeRunAt captures enum value "run_at"
+	ScheduleTypeRunAt string = "run_at"
+)
+
+// prop value enum
+func (m *Schedule) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, scheduleTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Schedule) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this schedule based on context it is used
+// ContextValidate validate this schedule based on the context it is used
 func (m *Schedule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTaskParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Schedule) contextValidateTaskParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TaskParams != nil {
+
+		if swag.IsZero(m.TaskParams) { // not required
+			return nil
+		}
+
+		if err := m.TaskParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -18,6 +18,15 @@ import (
 // swagger:model Task
 type Task struct {
 
+	// build task id
+	BuildTaskID int64 `json:"build_task_id,omitempty"`
+
+	// created
+	Created string `json:"created,omitempty"`
+
+	// end
+	End string `json:"end,omitempty"`
+
 	// environment
 	Environment string `json:"environment,omitempty"`
 
@@ -43,11 +52,17 @@ type Task struct {
 	// secret
 	Secret string `json:"secret,omitempty"`
 
+	// start
+	Start string `json:"start,omitempty"`
+
 	// status
 	Status string `json:"status,omitempty"`
 
 	// template id
 	TemplateID int64 `json:"template_id,omitempty"`
+
+	// version
+	Version string `json:"version,omitempty"`
 }
 
 // Validate validates this task
@@ -25,6 +25,14 @@ type Template struct {
 	// Example: false
 	AllowOverrideArgsInTask bool `json:"allow_override_args_in_task,omitempty"`
 
+	// allow override branch in task
+	// Example: false
+	AllowOverrideBranchInTask bool `json:"allow_override_branch_in_task,omitempty"`
+
+	// allow override inventory in task
+	// Example: false
+	AllowOverrideInventoryInTask bool `json:"allow_override_inventory_in_task,omitempty"`
+
 	// app
 	App string `json:"app,omitempty"`
 
@@ -82,6 +90,9 @@ type Template struct {
 	// survey vars
 	SurveyVars []*TemplateSurveyVar `json:"survey_vars"`
 
+	// task params
+	TaskParams *TemplateTaskParams `json:"task_params,omitempty"`
+
 	// type
 	// Enum: ["","build","deploy"]
 	Type string `json:"type,omitempty"`
@@ -118,6 +129,10 @@ func (m *Template) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTaskParams(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -210,6 +225,25 @@ func (m *Template) validateSurveyVars(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Template) validateTaskParams(formats strfmt.Registry) error {
+	if swag.IsZero(m.TaskParams) { // not required
+		return nil
+	}
+
+	if m.TaskParams != nil {
+		if err := m.TaskParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var templateTypeTypePropEnum []interface{}
 
 func init() {
@@ -301,6 +335,10 @@ func (m *Template) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTaskParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVaults(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -336,6 +374,27 @@ func (m *Template) contextValidateSurveyVars(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *Template) contextValidateTaskParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TaskParams != nil {
+
+		if swag.IsZero(m.TaskParams) { // not required
+			return nil
+		}
+
+		if err := m.TaskParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Template) contextValidateVaults(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Vaults); i++ {
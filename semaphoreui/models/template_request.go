@@ -25,6 +25,14 @@ type TemplateRequest struct {
 	// Example: false
 	AllowOverrideArgsInTask bool `json:"allow_override_args_in_task,omitempty"`
 
+	// allow override branch in task
+	// Example: false
+	AllowOverrideBranchInTask bool `json:"allow_override_branch_in_task,omitempty"`
+
+	// allow override inventory in task
+	// Example: false
+	AllowOverrideInventoryInTask bool `json:"allow_override_inventory_in_task,omitempty"`
+
 	// app
 	// Example: ansible
 	App string `json:"app,omitempty"`
@@ -87,6 +95,9 @@ type TemplateRequest struct {
 	// survey vars
 	SurveyVars []*TemplateSurveyVar `json:"survey_vars"`
 
+	// task params
+	TaskParams *TemplateTaskParams `json:"task_params,omitempty"`
+
 	// type
 	// Enum: ["","build","deploy"]
 	Type string `json:"type,omitempty"`
@@ -123,6 +134,10 @@ func (m *TemplateRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTaskParams(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -215,6 +230,25 @@ func (m *TemplateRequest) validateSurveyVars(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TemplateRequest) validateTaskParams(formats strfmt.Registry) error {
+	if swag.IsZero(m.TaskParams) { // not required
+		return nil
+	}
+
+	if m.TaskParams != nil {
+		if err := m.TaskParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var templateRequestTypeTypePropEnum []interface{}
 
 func init() {
@@ -306,6 +340,10 @@ func (m *TemplateRequest) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTaskParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVaults(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -341,6 +379,27 @@ func (m *TemplateRequest) contextValidateSurveyVars(ctx context.Context, formats
 	return nil
 }
 
+func (m *TemplateRequest) contextValidateTaskParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TaskParams != nil {
+
+		if swag.IsZero(m.TaskParams) { // not required
+			return nil
+		}
+
+		if err := m.TaskParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("task_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TemplateRequest) contextValidateVaults(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Vaults); i++ {
@@ -21,6 +21,9 @@ import (
 // swagger:model TemplateSurveyVar
 type TemplateSurveyVar struct {
 
+	// default value
+	DefaultValue string `json:"default_value,omitempty"`
+
 	// description
 	Description string `json:"description,omitempty"`
 